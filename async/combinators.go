@@ -0,0 +1,148 @@
+package async
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Alsond5/gofp"
+)
+
+type Task[T any] func(context.Context) gofp.Result[T]
+
+func FromFunc[T any](f func(context.Context) (T, error)) Task[T] {
+	return func(ctx context.Context) gofp.Result[T] {
+		return gofp.Of(f(ctx))
+	}
+}
+
+func FromFuture[T any](f *Future[T]) Task[T] {
+	return func(ctx context.Context) gofp.Result[T] {
+		r := f.Await(ctx)
+		if ctx.Err() != nil && f.Poll().IsNone() {
+			f.Cancel()
+		}
+
+		return r
+	}
+}
+
+func (t Task[T]) Go(ctx context.Context) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	return spawn(cancel, func() gofp.Result[T] {
+		return t(ctx)
+	})
+}
+
+type outcome[T any] struct {
+	index    int
+	result   gofp.Result[T]
+	panicked bool
+	panicVal any
+}
+
+func start[T any](ctx context.Context, limit int, tasks []Task[T]) <-chan outcome[T] {
+	out := make(chan outcome[T], len(tasks))
+	if limit <= 0 || limit > len(tasks) {
+		limit = len(tasks)
+	}
+
+	sem := make(chan struct{}, limit)
+	go func() {
+		for i, task := range tasks {
+			if ctx.Err() == nil {
+				select {
+				case sem <- struct{}{}:
+					go func() {
+						defer func() { <-sem }()
+
+						r, panicked, panicVal := capture(func() gofp.Result[T] { return task(ctx) })
+						out <- outcome[T]{index: i, result: r, panicked: panicked, panicVal: panicVal}
+					}()
+					continue
+				case <-ctx.Done():
+				}
+			}
+
+			out <- outcome[T]{index: i, result: gofp.Err[T](ctx.Err())}
+		}
+	}()
+
+	return out
+}
+
+func AllParallel[T any](ctx context.Context, limit int, tasks ...Task[T]) gofp.Result[[]T] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes := start(ctx, limit, tasks)
+	values := make([]T, len(tasks))
+	for range tasks {
+		o := <-outcomes
+		if o.panicked {
+			panic(o.panicVal)
+		}
+		if o.result.IsErr() {
+			return gofp.Err[[]T](o.result.UnwrapErr())
+		}
+
+		values[o.index] = o.result.Unwrap()
+	}
+
+	return gofp.Ok(values)
+}
+
+func AllSettled[T any](ctx context.Context, limit int, tasks ...Task[T]) []gofp.Result[T] {
+	outcomes := start(ctx, limit, tasks)
+	results := make([]gofp.Result[T], len(tasks))
+	for range tasks {
+		o := <-outcomes
+		if o.panicked {
+			panic(o.panicVal)
+		}
+
+		results[o.index] = o.result
+	}
+
+	return results
+}
+
+func Race[T any](ctx context.Context, limit int, tasks ...Task[T]) gofp.Result[T] {
+	if len(tasks) == 0 {
+		return gofp.Err[T](gofp.ErrNoResults)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	o := <-start(ctx, limit, tasks)
+	if o.panicked {
+		panic(o.panicVal)
+	}
+
+	return o.result
+}
+
+func AnyOk[T any](ctx context.Context, limit int, tasks ...Task[T]) gofp.Result[T] {
+	if len(tasks) == 0 {
+		return gofp.Err[T](gofp.ErrNoResults)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes := start(ctx, limit, tasks)
+	errs := make([]error, len(tasks))
+	for range tasks {
+		o := <-outcomes
+		if o.panicked {
+			panic(o.panicVal)
+		}
+		if o.result.IsOk() {
+			return o.result
+		}
+
+		errs[o.index] = o.result.UnwrapErr()
+	}
+
+	return gofp.Err[T](errors.Join(errs...))
+}
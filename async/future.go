@@ -0,0 +1,138 @@
+package async
+
+import (
+	"context"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/result"
+)
+
+type Future[T any] struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+
+	result   gofp.Result[T]
+	panicked bool
+	panicVal any
+}
+
+func Go[T any](f func() (T, error)) *Future[T] {
+	return spawn(nil, func() gofp.Result[T] {
+		return gofp.Of(f())
+	})
+}
+
+func GoResult[T any](f func() gofp.Result[T]) *Future[T] {
+	return spawn(nil, f)
+}
+
+func GoContext[T any](ctx context.Context, f func(context.Context) (T, error)) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	return spawn(cancel, func() gofp.Result[T] {
+		return gofp.Of(f(ctx))
+	})
+}
+
+func Resolved[T any](r gofp.Result[T]) *Future[T] {
+	fut := &Future[T]{done: make(chan struct{}), result: r}
+	close(fut.done)
+
+	return fut
+}
+
+func spawn[T any](cancel context.CancelFunc, f func() gofp.Result[T]) *Future[T] {
+	fut := &Future[T]{done: make(chan struct{}), cancel: cancel}
+	go fut.run(f)
+
+	return fut
+}
+
+func (f *Future[T]) run(fn func() gofp.Result[T]) {
+	defer close(f.done)
+	if f.cancel != nil {
+		defer f.cancel()
+	}
+
+	f.result, f.panicked, f.panicVal = capture(fn)
+}
+
+func capture[T any](fn func() gofp.Result[T]) (r gofp.Result[T], panicked bool, panicVal any) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			panicked, panicVal = true, rec
+		}
+	}()
+
+	return result.Flatten(gofp.Try(fn)), false, nil
+}
+
+func (f *Future[T]) get() gofp.Result[T] {
+	if f.panicked {
+		panic(f.panicVal)
+	}
+
+	return f.result
+}
+
+func (f *Future[T]) wait() gofp.Result[T] {
+	<-f.done
+	return f.get()
+}
+
+func (f *Future[T]) Await(ctx context.Context) gofp.Result[T] {
+	select {
+	case <-f.done:
+		return f.get()
+	default:
+	}
+
+	select {
+	case <-f.done:
+		return f.get()
+	case <-ctx.Done():
+		return gofp.Err[T](ctx.Err())
+	}
+}
+
+func (f *Future[T]) Poll() gofp.Option[gofp.Result[T]] {
+	select {
+	case <-f.done:
+		return gofp.Some(f.get())
+	default:
+		return gofp.None[gofp.Result[T]]()
+	}
+}
+
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+func (f *Future[T]) Cancel() {
+	if f.cancel != nil {
+		f.cancel()
+	}
+}
+
+func Map[T, U any](f *Future[T], fn func(T) U) *Future[U] {
+	return spawn(f.cancel, func() gofp.Result[U] {
+		return result.Map(f.wait(), fn)
+	})
+}
+
+func Then[T, U any](f *Future[T], fn func(T) gofp.Result[U]) *Future[U] {
+	return spawn(f.cancel, func() gofp.Result[U] {
+		return result.FlatMap(f.wait(), fn)
+	})
+}
+
+func MapErr[T any](f *Future[T], fn func(error) error) *Future[T] {
+	return spawn(f.cancel, func() gofp.Result[T] {
+		return f.wait().MapErr(fn)
+	})
+}
+
+func OrElse[T any](f *Future[T], fn func(error) gofp.Result[T]) *Future[T] {
+	return spawn(f.cancel, func() gofp.Result[T] {
+		return f.wait().OrElse(fn)
+	})
+}
@@ -0,0 +1,224 @@
+package breaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/clock"
+)
+
+const ErrOpen gofp.ResultError = "breaker: circuit open"
+
+type State int
+
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+type Settings struct {
+	WindowSize       int
+	MinCalls         int
+	FailureThreshold float64
+	OpenTimeout      time.Duration
+	HalfOpenProbes   int
+	IsFailure        func(error) bool
+	OnStateChange    func(from, to State)
+	Clock            clock.Clock
+}
+
+type Breaker struct {
+	settings Settings
+
+	mu         sync.Mutex
+	state      State
+	generation uint64
+	openedAt   time.Time
+
+	window   []bool
+	next     int
+	calls    int
+	failures int
+
+	probes    int
+	successes int
+}
+
+func New(s Settings) *Breaker {
+	if s.WindowSize <= 0 {
+		s.WindowSize = 20
+	}
+	if s.MinCalls <= 0 || s.MinCalls > s.WindowSize {
+		s.MinCalls = s.WindowSize
+	}
+	if s.FailureThreshold <= 0 || s.FailureThreshold > 1 {
+		s.FailureThreshold = 0.5
+	}
+	if s.OpenTimeout <= 0 {
+		s.OpenTimeout = 60 * time.Second
+	}
+	if s.HalfOpenProbes <= 0 {
+		s.HalfOpenProbes = 1
+	}
+	if s.Clock == nil {
+		s.Clock = clock.System()
+	}
+
+	return &Breaker{settings: s, window: make([]bool, s.WindowSize)}
+}
+
+func Call[T any](b *Breaker, f func() gofp.Result[T]) gofp.Result[T] {
+	gen, err := b.allow()
+	if err != nil {
+		return gofp.Err[T](err)
+	}
+
+	done := false
+	defer func() {
+		if !done {
+			b.record(gen, true)
+		}
+	}()
+
+	r := f()
+	done = true
+	b.record(gen, r.IsErrAnd(b.isFailure))
+
+	return r
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == Open && b.openTimedOut() {
+		return HalfOpen
+	}
+
+	return b.state
+}
+
+func (b *Breaker) Reset() {
+	b.mu.Lock()
+	from := b.state
+	b.setState(Closed)
+	b.mu.Unlock()
+
+	b.notify(from, Closed)
+}
+
+func (b *Breaker) isFailure(err error) bool {
+	return b.settings.IsFailure == nil || b.settings.IsFailure(err)
+}
+
+func (b *Breaker) openTimedOut() bool {
+	return b.settings.Clock.Now().Sub(b.openedAt) >= b.settings.OpenTimeout
+}
+
+func (b *Breaker) allow() (uint64, error) {
+	b.mu.Lock()
+
+	from := b.state
+	if b.state == Open && b.openTimedOut() {
+		b.setState(HalfOpen)
+	}
+
+	var err error
+	switch b.state {
+	case Open:
+		err = ErrOpen
+	case HalfOpen:
+		if b.probes >= b.settings.HalfOpenProbes {
+			err = ErrOpen
+		} else {
+			b.probes++
+		}
+	}
+
+	gen, to := b.generation, b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+	return gen, err
+}
+
+func (b *Breaker) record(gen uint64, failed bool) {
+	b.mu.Lock()
+
+	from := b.state
+	if gen == b.generation {
+		switch b.state {
+		case Closed:
+			b.push(failed)
+			if b.calls >= b.settings.MinCalls && float64(b.failures)/float64(b.calls) >= b.settings.FailureThreshold {
+				b.setState(Open)
+			}
+		case HalfOpen:
+			if failed {
+				b.setState(Open)
+			} else {
+				b.successes++
+				if b.successes >= b.settings.HalfOpenProbes {
+					b.setState(Closed)
+				}
+			}
+		}
+	}
+
+	to := b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+}
+
+func (b *Breaker) push(failed bool) {
+	if b.calls == len(b.window) {
+		if b.window[b.next] {
+			b.failures--
+		}
+	} else {
+		b.calls++
+	}
+
+	b.window[b.next] = failed
+	if failed {
+		b.failures++
+	}
+
+	b.next = (b.next + 1) % len(b.window)
+}
+
+func (b *Breaker) setState(s State) {
+	b.state = s
+	b.generation++
+	b.probes, b.successes = 0, 0
+
+	switch s {
+	case Closed:
+		clear(b.window)
+		b.next, b.calls, b.failures = 0, 0, 0
+	case Open:
+		b.openedAt = b.settings.Clock.Now()
+	}
+}
+
+func (b *Breaker) notify(from, to State) {
+	if from != to && b.settings.OnStateChange != nil {
+		b.settings.OnStateChange(from, to)
+	}
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	gofpPath  = "github.com/Alsond5/gofp"
+	tuplePath = "github.com/Alsond5/gofp/tuple"
+)
+
+var tupleNames = [...]string{2: "Pair", 3: "Triple", 4: "Tuple4", 5: "Tuple5", 6: "Tuple6", 7: "Tuple7", 8: "Tuple8", 9: "Tuple9"}
+
+type generator struct {
+	src     *types.Package
+	pkgName string
+	methods bool
+
+	imports  map[string]string
+	names    map[string]bool
+	declared map[string]bool
+	warnings []string
+
+	body bytes.Buffer
+}
+
+func newGenerator(src *types.Package, pkgName string, methods bool) *generator {
+	if pkgName == "" {
+		pkgName = src.Name()
+	}
+
+	g := &generator{
+		src:      src,
+		pkgName:  pkgName,
+		methods:  methods,
+		imports:  make(map[string]string),
+		names:    make(map[string]bool),
+		declared: make(map[string]bool),
+	}
+	g.names["gofp"] = true
+
+	return g
+}
+
+func (g *generator) generate() ([]byte, error) {
+	scope := g.src.Scope()
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		if !obj.Exported() {
+			continue
+		}
+
+		switch obj := obj.(type) {
+		case *types.Func:
+			g.wrap(obj.Name(), nil, obj)
+		case *types.TypeName:
+			if g.methods && !obj.IsAlias() {
+				g.wrapMethods(obj)
+			}
+		}
+	}
+
+	if len(g.declared) == 0 {
+		return nil, fmt.Errorf("%s has no exported functions returning error", g.src.Path())
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gofpgen from %s. DO NOT EDIT.\n\n", g.src.Path())
+	fmt.Fprintf(&out, "package %s\n\n", g.pkgName)
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	slices.SortFunc(paths, func(a, b string) int {
+		if isStdlib(a) != isStdlib(b) {
+			if isStdlib(a) {
+				return -1
+			}
+
+			return 1
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	out.WriteString("import (\n")
+	for i, path := range paths {
+		if i > 0 && isStdlib(paths[i-1]) && !isStdlib(path) {
+			out.WriteString("\n")
+		}
+		if local := g.imports[path]; local != path[strings.LastIndex(path, "/")+1:] {
+			fmt.Fprintf(&out, "\t%s %s\n", local, strconv.Quote(path))
+		} else {
+			fmt.Fprintf(&out, "\t%s\n", strconv.Quote(path))
+		}
+	}
+	out.WriteString(")\n")
+	out.Write(g.body.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+func (g *generator) wrapMethods(tn *types.TypeName) {
+	named, ok := tn.Type().(*types.Named)
+	if !ok {
+		return
+	}
+	if _, isInterface := named.Underlying().(*types.Interface); isInterface {
+		return
+	}
+
+	for m := range named.Methods() {
+		if m.Exported() && m.Name() != "Unwrap" {
+			g.wrap(tn.Name()+m.Name(), named, m)
+		}
+	}
+}
+
+func (g *generator) wrap(name string, recvType *types.Named, fn *types.Func) {
+	sig := fn.Signature()
+	values, ok := classify(sig.Results())
+	if !ok {
+		return
+	}
+
+	if !exportable(sig) {
+		g.warnings = append(g.warnings, fmt.Sprintf("skipping %s: signature uses unexported types", name))
+		return
+	}
+	if g.declared[name] {
+		g.warnings = append(g.warnings, fmt.Sprintf("skipping %s: name already generated", name))
+		return
+	}
+	g.declared[name] = true
+	g.imports[gofpPath] = "gofp"
+
+	var tparams *types.TypeParamList
+	if recvType != nil {
+		tparams = sig.RecvTypeParams()
+	} else {
+		tparams = sig.TypeParams()
+	}
+
+	var resultType, bridge string
+	switch len(values) {
+	case 0:
+		resultType, bridge = "gofp.Result[gofp.Unit]", "gofp.Do"
+	case 1:
+		resultType, bridge = "gofp.Result["+g.typeString(values[0])+"]", "gofp.Of"
+	default:
+		resultType = "gofp.Result[" + g.importName(tuplePath, "tuple") + "." + tupleNames[len(values)] + "[" + g.typeList(values) + "]]"
+		bridge = "gofp.Of" + strconv.Itoa(len(values))
+	}
+
+	var vars []*types.Var
+	var paramTypes []string
+	if recvType != nil {
+		vars = append(vars, sig.Recv())
+		paramTypes = append(paramTypes, g.typeString(sig.Recv().Type()))
+	}
+	for i := range sig.Params().Len() {
+		p := sig.Params().At(i)
+		vars = append(vars, p)
+
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			paramTypes = append(paramTypes, "..."+g.typeString(p.Type().(*types.Slice).Elem()))
+		} else {
+			paramTypes = append(paramTypes, g.typeString(p.Type()))
+		}
+	}
+
+	tparamDecl := g.typeParamDecl(tparams)
+	pkg := g.importName(g.src.Path(), g.src.Name())
+
+	params := make([]string, len(vars))
+	args := make([]string, len(vars))
+	used := map[string]bool{}
+	for i, v := range vars {
+		args[i] = g.paramName(v.Name(), "p"+strconv.Itoa(i), used)
+		params[i] = args[i] + " " + paramTypes[i]
+		if strings.HasPrefix(paramTypes[i], "...") {
+			args[i] += "..."
+		}
+	}
+
+	call := pkg + "." + fn.Name()
+	if recvType != nil {
+		call = args[0] + "." + fn.Name()
+		args = args[1:]
+	} else if tparams.Len() > 0 {
+		call += "[" + strings.Join(g.typeParamNames(tparams), ", ") + "]"
+	}
+	call += "(" + strings.Join(args, ", ") + ")"
+
+	fmt.Fprintf(&g.body, "\nfunc %s%s(%s) %s {\n\treturn %s(%s)\n}\n",
+		name, tparamDecl, strings.Join(params, ", "), resultType, bridge, call)
+}
+
+func isStdlib(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+func classify(results *types.Tuple) ([]types.Type, bool) {
+	n := results.Len()
+	if n == 0 || n > len(tupleNames) || !isError(results.At(n-1).Type()) {
+		return nil, false
+	}
+
+	values := make([]types.Type, 0, n-1)
+	for i := range n - 1 {
+		values = append(values, results.At(i).Type())
+	}
+
+	return values, true
+}
+
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+func exportable(sig *types.Signature) bool {
+	ok := true
+	visit := func(t types.Type) {
+		walkType(t, func(named *types.Named) {
+			if obj := named.Obj(); obj.Pkg() != nil && !obj.Exported() {
+				ok = false
+			}
+		}, map[types.Type]bool{})
+	}
+
+	for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+		for v := range tuple.Variables() {
+			visit(v.Type())
+		}
+	}
+	if recv := sig.Recv(); recv != nil {
+		visit(recv.Type())
+	}
+
+	return ok
+}
+
+func walkType(t types.Type, f func(*types.Named), seen map[types.Type]bool) {
+	if seen[t] {
+		return
+	}
+	seen[t] = true
+
+	switch t := t.(type) {
+	case *types.Named:
+		f(t)
+		for arg := range t.TypeArgs().Types() {
+			walkType(arg, f, seen)
+		}
+	case *types.Alias:
+		walkType(types.Unalias(t), f, seen)
+	case *types.Pointer:
+		walkType(t.Elem(), f, seen)
+	case *types.Slice:
+		walkType(t.Elem(), f, seen)
+	case *types.Array:
+		walkType(t.Elem(), f, seen)
+	case *types.Chan:
+		walkType(t.Elem(), f, seen)
+	case *types.Map:
+		walkType(t.Key(), f, seen)
+		walkType(t.Elem(), f, seen)
+	case *types.Signature:
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for v := range tuple.Variables() {
+				walkType(v.Type(), f, seen)
+			}
+		}
+	case *types.Struct:
+		for field := range t.Fields() {
+			walkType(field.Type(), f, seen)
+		}
+	}
+}
+
+func (g *generator) importName(path, name string) string {
+	if local, ok := g.imports[path]; ok {
+		return local
+	}
+
+	local := name
+	for i := 2; g.names[local] || token.Lookup(local).IsKeyword(); i++ {
+		local = name + strconv.Itoa(i)
+	}
+
+	g.imports[path] = local
+	g.names[local] = true
+
+	return local
+}
+
+func (g *generator) qualifier(pkg *types.Package) string {
+	return g.importName(pkg.Path(), pkg.Name())
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+func (g *generator) typeList(ts []types.Type) string {
+	parts := make([]string, len(ts))
+	for i, t := range ts {
+		parts[i] = g.typeString(t)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func (g *generator) typeParamNames(tparams *types.TypeParamList) []string {
+	names := make([]string, tparams.Len())
+	for i := range tparams.Len() {
+		names[i] = tparams.At(i).Obj().Name()
+	}
+
+	return names
+}
+
+func (g *generator) typeParamDecl(tparams *types.TypeParamList) string {
+	if tparams.Len() == 0 {
+		return ""
+	}
+
+	parts := make([]string, tparams.Len())
+	for i := range tparams.Len() {
+		tp := tparams.At(i)
+		parts[i] = tp.Obj().Name() + " " + g.typeString(tp.Constraint())
+	}
+
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+func (g *generator) paramName(name, fallback string, used map[string]bool) string {
+	if name == "" || name == "_" || g.names[name] {
+		name = fallback
+	}
+	for used[name] || g.names[name] {
+		name += "_"
+	}
+
+	used[name] = true
+	return name
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"golang.org/x/tools/go/packages"
+)
+
+func main() {
+	pkgName := flag.String("pkg", "", "name of the generated package (default: the source package name)")
+	output := flag.String("o", "", "output file (default: stdout)")
+	methods := flag.Bool("methods", true, "also wrap methods of exported types")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: gofpgen [flags] <package>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), *pkgName, *output, *methods); err != nil {
+		fmt.Fprintln(os.Stderr, "gofpgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(pattern, pkgName, output string, methods bool) error {
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedTypes}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return fmt.Errorf("failed to load %s", pattern)
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("%s matched %d packages, expected 1", pattern, len(pkgs))
+	}
+
+	g := newGenerator(pkgs[0].Types, pkgName, methods)
+	src, err := g.generate()
+	for _, warning := range g.warnings {
+		fmt.Fprintln(os.Stderr, "gofpgen:", warning)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+
+	return os.WriteFile(output, src, 0o644)
+}
@@ -0,0 +1,257 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	gofpPath    = "github.com/Alsond5/gofp"
+	resultePath = "github.com/Alsond5/gofp/resulte"
+	asyncPath   = "github.com/Alsond5/gofp/async"
+	mustPath    = "github.com/Alsond5/gofp/must"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "gofpvet",
+	Doc:      "reports unchecked Unwrap/Expect calls, discarded Results and must.* calls outside init or main",
+	URL:      "https://github.com/Alsond5/gofp",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var (
+	checkUnwrap  bool
+	checkDiscard bool
+	checkMust    bool
+)
+
+func init() {
+	Analyzer.Flags.BoolVar(&checkUnwrap, "unwrap", true, "report Unwrap/Expect calls not guarded by IsOk/IsSome or enclosed in Try")
+	Analyzer.Flags.BoolVar(&checkDiscard, "discard", true, "report discarded Result values")
+	Analyzer.Flags.BoolVar(&checkMust, "must", true, "report must.* calls outside init and main")
+}
+
+var sideEffectMethods = map[string]bool{
+	"IfOk":  true,
+	"IfErr": true,
+	"Tap":   true,
+}
+
+var panicSafeFuncs = map[string]map[string]bool{
+	gofpPath: {"Try": true, "TryCatch": true, "NewLazy": true, "NewLazyRetry": true},
+	asyncPath: {
+		"Go": true, "GoResult": true, "GoContext": true,
+		"Map": true, "Then": true, "MapErr": true, "OrElse": true,
+	},
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{(*ast.CallExpr)(nil), (*ast.ExprStmt)(nil)}
+	insp.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			if checkDiscard {
+				checkDiscarded(pass, n)
+			}
+		case *ast.CallExpr:
+			if checkUnwrap {
+				checkUnwrapCall(pass, n, stack)
+			}
+			if checkMust {
+				checkMustCall(pass, n, stack)
+			}
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+func checkUnwrapCall(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Unwrap" && sel.Sel.Name != "Expect") {
+		return
+	}
+
+	kind := wrapperKind(pass.TypesInfo.TypeOf(sel.X))
+	if kind == "" || isConstructed(pass, sel.X) || isRaise(pass, sel) || insidePanicSafe(pass, stack) {
+		return
+	}
+
+	key := types.ExprString(sel.X)
+	if guarded(key, stack) {
+		return
+	}
+
+	check := "IsOk"
+	if kind == "Option" {
+		check = "IsSome"
+	}
+
+	pass.Reportf(call.Pos(), "%s on %s is not guarded by %s and not enclosed in gofp.Try", sel.Sel.Name, kind, check)
+}
+
+func checkDiscarded(pass *analysis.Pass, stmt *ast.ExprStmt) {
+	call, ok := ast.Unparen(stmt.X).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	if wrapperKind(pass.TypesInfo.TypeOf(call)) != "Result" {
+		return
+	}
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sideEffectMethods[sel.Sel.Name] {
+		if _, isMethod := pass.TypesInfo.Selections[sel]; isMethod {
+			return
+		}
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: "Result value is discarded",
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Mark the Result as deliberately ignored with _ = (the error is still not handled)",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     stmt.Pos(),
+				End:     stmt.Pos(),
+				NewText: []byte("_ = "),
+			}},
+		}},
+	})
+}
+
+func checkMustCall(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	fn := calledFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != mustPath || pass.Pkg.Path() == mustPath {
+		return
+	}
+
+	if strings.HasSuffix(pass.Fset.File(call.Pos()).Name(), "_test.go") {
+		return
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		decl, ok := stack[i].(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		if decl.Recv == nil && (decl.Name.Name == "init" || decl.Name.Name == "main" && pass.Pkg.Name() == "main") {
+			return
+		}
+
+		pass.Reportf(call.Pos(), "must.%s panics on failure; call it only from init, main or package-level variable initializers", fn.Name())
+		return
+	}
+}
+
+func wrapperKind(t types.Type) string {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return ""
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return ""
+	}
+
+	switch {
+	case obj.Pkg().Path() == gofpPath && (obj.Name() == "Result" || obj.Name() == "Option"):
+		return obj.Name()
+	case obj.Pkg().Path() == resultePath && obj.Name() == "Result":
+		return "Result"
+	}
+
+	return ""
+}
+
+func calledFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fun := ast.Unparen(call.Fun)
+	if idx, ok := fun.(*ast.IndexExpr); ok {
+		fun = idx.X
+	} else if idx, ok := fun.(*ast.IndexListExpr); ok {
+		fun = idx.X
+	}
+
+	var id *ast.Ident
+	switch f := fun.(type) {
+	case *ast.Ident:
+		id = f
+	case *ast.SelectorExpr:
+		id = f.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := pass.TypesInfo.Uses[id].(*types.Func)
+	return fn
+}
+
+func isConstructed(pass *analysis.Pass, x ast.Expr) bool {
+	call, ok := ast.Unparen(x).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fn := calledFunc(pass, call)
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == gofpPath && (fn.Name() == "Ok" || fn.Name() == "Some")
+}
+
+func isRaise(pass *analysis.Pass, sel *ast.SelectorExpr) bool {
+	if pass.Pkg.Path() != resultePath || sel.Sel.Name != "Unwrap" {
+		return false
+	}
+
+	call, ok := ast.Unparen(sel.X).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fn := calledFunc(pass, call)
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == gofpPath && fn.Name() == "Err"
+}
+
+func insidePanicSafe(pass *analysis.Pass, stack []ast.Node) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		lit, ok := stack[i].(*ast.FuncLit)
+		if !ok {
+			continue
+		}
+
+		call, ok := stack[i-1].(*ast.CallExpr)
+		if !ok || !isArg(call, lit) {
+			continue
+		}
+
+		fn := calledFunc(pass, call)
+		if fn != nil && fn.Pkg() != nil && panicSafeFuncs[fn.Pkg().Path()][fn.Name()] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isArg(call *ast.CallExpr, lit *ast.FuncLit) bool {
+	for _, arg := range call.Args {
+		if ast.Unparen(arg) == lit {
+			return true
+		}
+	}
+
+	return false
+}
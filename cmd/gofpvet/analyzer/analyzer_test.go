@@ -0,0 +1,20 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/Alsond5/gofp/cmd/gofpvet/analyzer"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestUnwrap(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), analyzer.Analyzer, "./unwrap")
+}
+
+func TestDiscard(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), analyzer.Analyzer, "./discard")
+}
+
+func TestMust(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), analyzer.Analyzer, "./mustcheck", "./mustmain")
+}
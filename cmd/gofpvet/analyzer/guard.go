@@ -0,0 +1,137 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+var (
+	positiveChecks = map[string]bool{"IsOk": true, "IsOkAnd": true, "IsSome": true, "IsSomeAnd": true}
+	negativeChecks = map[string]bool{"IsErr": true, "IsNone": true}
+)
+
+func guarded(key string, stack []ast.Node) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		child, parent := stack[i], stack[i-1]
+
+		switch p := parent.(type) {
+		case *ast.IfStmt:
+			pos, neg := condition(key, p.Cond)
+			if child == p.Body && pos || child == p.Else && neg {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child != p.Y {
+				continue
+			}
+
+			pos, neg := condition(key, p.X)
+			if p.Op == token.LAND && pos || p.Op == token.LOR && neg {
+				return true
+			}
+		case *ast.CaseClause:
+			if earlyExit(key, p.Body, child) {
+				return true
+			}
+			if !isTaglessSwitchCase(stack, i-1) {
+				continue
+			}
+
+			for _, expr := range p.List {
+				if pos, _ := condition(key, expr); pos {
+					return true
+				}
+			}
+		case *ast.BlockStmt:
+			if earlyExit(key, p.List, child) {
+				return true
+			}
+		case *ast.CommClause:
+			if earlyExit(key, p.Body, child) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isTaglessSwitchCase(stack []ast.Node, clause int) bool {
+	if clause < 2 {
+		return false
+	}
+
+	sw, ok := stack[clause-2].(*ast.SwitchStmt)
+	return ok && sw.Tag == nil
+}
+
+func earlyExit(key string, stmts []ast.Stmt, child ast.Node) bool {
+	for _, stmt := range stmts {
+		if stmt == child {
+			return false
+		}
+
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Else != nil || !terminates(ifStmt.Body) {
+			continue
+		}
+		if _, neg := condition(key, ifStmt.Cond); neg {
+			return true
+		}
+	}
+
+	return false
+}
+
+func terminates(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return false
+	}
+
+	switch last := body.List[len(body.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		id, ok := call.Fun.(*ast.Ident)
+		return ok && id.Name == "panic"
+	}
+
+	return false
+}
+
+func condition(key string, cond ast.Expr) (pos, neg bool) {
+	switch c := ast.Unparen(cond).(type) {
+	case *ast.CallExpr:
+		sel, ok := c.Fun.(*ast.SelectorExpr)
+		if !ok || types.ExprString(sel.X) != key {
+			return false, false
+		}
+
+		return positiveChecks[sel.Sel.Name], negativeChecks[sel.Sel.Name]
+	case *ast.UnaryExpr:
+		if c.Op != token.NOT {
+			return false, false
+		}
+
+		pos, neg = condition(key, c.X)
+		return neg, pos
+	case *ast.BinaryExpr:
+		xPos, xNeg := condition(key, c.X)
+		yPos, yNeg := condition(key, c.Y)
+
+		switch c.Op {
+		case token.LAND:
+			return xPos || yPos, xNeg && yNeg
+		case token.LOR:
+			return xPos && yPos, xNeg || yNeg
+		}
+	}
+
+	return false, false
+}
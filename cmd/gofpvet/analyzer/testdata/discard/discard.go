@@ -0,0 +1,12 @@
+package discard
+
+import "github.com/Alsond5/gofp"
+
+func save() gofp.Result[gofp.Unit] { return gofp.Ok(gofp.Unit{}) }
+
+func run() {
+	save() // want `Result value is discarded`
+
+	save().IfErr(func(error) {})
+	_ = save()
+}
@@ -0,0 +1,7 @@
+module vettest
+
+go 1.25.7
+
+require github.com/Alsond5/gofp v0.0.0
+
+replace github.com/Alsond5/gofp => ../../../..
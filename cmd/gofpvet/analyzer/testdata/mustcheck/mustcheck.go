@@ -0,0 +1,23 @@
+package mustcheck
+
+import (
+	"strconv"
+
+	"github.com/Alsond5/gofp/must"
+)
+
+var port = must.Do(strconv.Atoi("8080"))
+
+var limit int
+
+func init() {
+	limit = must.Do(strconv.Atoi("10"))
+}
+
+func parse(s string) int {
+	return must.Do(strconv.Atoi(s)) // want `must.Do panics on failure; call it only from init, main or package-level variable initializers`
+}
+
+func main() {
+	must.Be(port > 0, "port") // want `must.Be panics on failure`
+}
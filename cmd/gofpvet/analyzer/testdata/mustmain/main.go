@@ -0,0 +1,11 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/Alsond5/gofp/must"
+)
+
+func main() {
+	_ = must.Do(strconv.Atoi("1"))
+}
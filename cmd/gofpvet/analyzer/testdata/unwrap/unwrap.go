@@ -0,0 +1,117 @@
+package unwrap
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/async"
+)
+
+func load() gofp.Result[int] { return gofp.Err[int](errors.New("boom")) }
+
+func find() gofp.Option[int] { return gofp.None[int]() }
+
+func guardedIf() int {
+	r := load()
+	if r.IsOk() {
+		return r.Unwrap()
+	}
+
+	return 0
+}
+
+func guardedElse() int {
+	o := find()
+	if o.IsNone() {
+		return 0
+	} else {
+		return o.Unwrap()
+	}
+}
+
+func earlyReturn() int {
+	r := load()
+	if r.IsErr() {
+		return 0
+	}
+
+	return r.Unwrap()
+}
+
+func guardedAnd() bool {
+	o := find()
+	return o.IsSome() && o.Unwrap() > 0
+}
+
+func guardedSwitch() int {
+	r := load()
+	switch {
+	case r.IsOk():
+		return r.Unwrap()
+	}
+
+	return 0
+}
+
+func unguarded() int {
+	r := load()
+	return r.Unwrap() // want `Unwrap on Result is not guarded by IsOk and not enclosed in gofp.Try`
+}
+
+func unguardedExpect() int {
+	o := find()
+	return o.Expect("needed") // want `Expect on Option is not guarded by IsSome and not enclosed in gofp.Try`
+}
+
+func wrongBranch() int {
+	r := load()
+	if r.IsErr() {
+		return r.Unwrap() // want `Unwrap on Result is not guarded by IsOk`
+	}
+
+	return 0
+}
+
+func otherValueChecked() int {
+	r, other := load(), load()
+	if other.IsOk() {
+		return r.Unwrap() // want `Unwrap on Result is not guarded by IsOk`
+	}
+
+	return 0
+}
+
+func insideTry() gofp.Result[int] {
+	return gofp.Try(func() int {
+		return load().Unwrap() + 1
+	})
+}
+
+func insideTryCatch() {
+	gofp.TryCatch(func() int {
+		return load().Unwrap()
+	}, func(err error) string {
+		return err.Error()
+	})
+}
+
+var cached = gofp.NewLazy(func() gofp.Result[int] {
+	return gofp.Ok(load().Unwrap() * 2)
+})
+
+var retried = gofp.NewLazyRetry(func() gofp.Result[int] {
+	return gofp.Ok(find().Expect("configured"))
+})
+
+var task = async.FromFunc(func(context.Context) (int, error) {
+	return load().Unwrap(), nil // want `Unwrap on Result is not guarded by IsOk`
+})
+
+func constructed() int {
+	return gofp.Ok(1).Unwrap() + gofp.Some(2).Unwrap()
+}
+
+func raise(err error) {
+	gofp.Err[gofp.Unit](err).Unwrap() // want `Unwrap on Result is not guarded by IsOk`
+}
@@ -0,0 +1,177 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+const ResultErrorCode = "gofp.ResultError"
+
+type ErrorCodec interface {
+	EncodeError(err error) ([]byte, error)
+	DecodeError(data []byte) (error, error)
+}
+
+type ResultEncoding struct {
+	OkKey  string
+	ErrKey string
+	Errors ErrorCodec
+}
+
+var resultEncoding atomic.Pointer[ResultEncoding]
+
+func init() {
+	resultEncoding.Store(&ResultEncoding{OkKey: "ok", ErrKey: "err", Errors: MessageCodec{}})
+}
+
+func SetResultEncoding(enc ResultEncoding) {
+	if enc.OkKey == "" {
+		enc.OkKey = "ok"
+	}
+	if enc.ErrKey == "" {
+		enc.ErrKey = "err"
+	}
+	if enc.Errors == nil {
+		enc.Errors = MessageCodec{}
+	}
+
+	resultEncoding.Store(&enc)
+}
+
+func CurrentResultEncoding() ResultEncoding {
+	return *resultEncoding.Load()
+}
+
+type MessageCodec struct{}
+
+func (MessageCodec) EncodeError(err error) ([]byte, error) {
+	return json.Marshal(err.Error())
+}
+
+func (MessageCodec) DecodeError(data []byte) (error, error) {
+	var msg string
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+
+	return errors.New(msg), nil
+}
+
+type wireError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+	Cause   string `json:"cause,omitempty"`
+}
+
+type codedError struct {
+	msg   string
+	cause error
+}
+
+func (e *codedError) Error() string { return e.msg }
+
+func (e *codedError) Unwrap() error { return e.cause }
+
+type ErrorRegistry struct {
+	mu     sync.RWMutex
+	names  []string
+	byName map[string]error
+}
+
+func NewErrorRegistry() *ErrorRegistry {
+	return &ErrorRegistry{byName: make(map[string]error)}
+}
+
+func (r *ErrorRegistry) Register(name string, err error) *ErrorRegistry {
+	if name == "" || name == ResultErrorCode {
+		panic(fmt.Sprintf("gofp.ErrorRegistry: reserved error name %q", name))
+	}
+	if err == nil {
+		panic(fmt.Sprintf("gofp.ErrorRegistry: nil error registered as %q", name))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byName[name]; !ok {
+		r.names = append(r.names, name)
+	}
+	r.byName[name] = err
+
+	return r
+}
+
+func (r *ErrorRegistry) Lookup(name string) Option[error] {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	err, ok := r.byName[name]
+	if !ok {
+		return None[error]()
+	}
+
+	return Some(err)
+}
+
+func (r *ErrorRegistry) EncodeError(err error) ([]byte, error) {
+	w := wireError{Message: err.Error()}
+
+	r.mu.RLock()
+	for _, name := range r.names {
+		if errors.Is(err, r.byName[name]) {
+			w.Code = name
+			break
+		}
+	}
+	r.mu.RUnlock()
+
+	var re ResultError
+	if w.Code == "" && errors.As(err, &re) {
+		w.Code = ResultErrorCode
+		if string(re) != w.Message {
+			w.Cause = string(re)
+		}
+	}
+
+	return json.Marshal(w)
+}
+
+func (r *ErrorRegistry) DecodeError(data []byte) (error, error) {
+	var w wireError
+	if err := json.Unmarshal(data, &w); err != nil {
+		var msg string
+		if json.Unmarshal(data, &msg) != nil {
+			return nil, err
+		}
+
+		w.Message = msg
+	}
+
+	var cause error
+	switch w.Code {
+	case "":
+		return errors.New(w.Message), nil
+	case ResultErrorCode:
+		if w.Cause == "" {
+			return ResultError(w.Message), nil
+		}
+
+		cause = ResultError(w.Cause)
+	default:
+		sentinel := r.Lookup(w.Code)
+		if sentinel.IsNone() {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownErrorCode, w.Code)
+		}
+
+		cause = sentinel.Unwrap()
+	}
+
+	if cause.Error() == w.Message {
+		return cause, nil
+	}
+
+	return &codedError{msg: w.Message, cause: cause}, nil
+}
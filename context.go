@@ -0,0 +1,129 @@
+package gofp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+const badKey = "!BADKEY"
+
+type contextError struct {
+	msg   string
+	attrs []slog.Attr
+	err   error
+}
+
+func (e *contextError) Error() string {
+	if e.msg == "" {
+		return e.err.Error()
+	}
+
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *contextError) Unwrap() error { return e.err }
+
+func (e *contextError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			if e.msg != "" {
+				io.WriteString(s, e.msg+": ")
+			}
+			fmt.Fprintf(s, "%+v", e.err)
+
+			return
+		}
+
+		io.WriteString(s, e.Error())
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
+func (r Result[T]) Context(msg string) Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return Err[T](&contextError{msg: msg, err: r.err})
+}
+
+func (r Result[T]) Contextf(format string, args ...any) Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return Err[T](&contextError{msg: fmt.Sprintf(format, args...), err: r.err})
+}
+
+func (r Result[T]) WithContext(f func() string) Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return Err[T](&contextError{msg: f(), err: r.err})
+}
+
+func (r Result[T]) With(args ...any) Result[T] {
+	if r.ok {
+		return r
+	}
+
+	return Err[T](&contextError{attrs: argsToAttrs(args), err: r.err})
+}
+
+func ContextAttrs(err error) []slog.Attr {
+	var attrs []slog.Attr
+	walkErrors(err, func(err error) {
+		if ce, ok := err.(*contextError); ok {
+			attrs = append(attrs, ce.attrs...)
+		}
+	})
+
+	return attrs
+}
+
+func walkErrors(err error, f func(error)) {
+	for err != nil {
+		f(err)
+
+		if multi, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range multi.Unwrap() {
+				walkErrors(inner, f)
+			}
+
+			return
+		}
+
+		err = errors.Unwrap(err)
+	}
+}
+
+func argsToAttrs(args []any) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(args)/2)
+	for len(args) > 0 {
+		switch x := args[0].(type) {
+		case slog.Attr:
+			attrs = append(attrs, x)
+			args = args[1:]
+		case string:
+			if len(args) == 1 {
+				attrs = append(attrs, slog.String(badKey, x))
+				args = nil
+			} else {
+				attrs = append(attrs, slog.Any(x, args[1]))
+				args = args[2:]
+			}
+		default:
+			attrs = append(attrs, slog.Any(badKey, x))
+			args = args[1:]
+		}
+	}
+
+	return attrs
+}
@@ -0,0 +1,44 @@
+package either
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+func (e Either[L, R]) LogValue() slog.Value {
+	if e.isLeft {
+		return slog.GroupValue(slog.String("side", "left"), slog.Any("value", e.left))
+	}
+
+	return slog.GroupValue(slog.String("side", "right"), slog.Any("value", e.right))
+}
+
+func (e Either[L, R]) String() string {
+	return fmt.Sprint(e)
+}
+
+func (e Either[L, R]) GoString() string {
+	return fmt.Sprintf("%#v", e)
+}
+
+func (e Either[L, R]) Format(s fmt.State, verb rune) {
+	inner := fmt.FormatString(s, verb)
+
+	if verb == 'v' && s.Flag('#') {
+		l, r := reflect.TypeFor[L]().String(), reflect.TypeFor[R]().String()
+		if e.isLeft {
+			fmt.Fprintf(s, "either.Left[%s, %s]("+inner+")", l, r, e.left)
+		} else {
+			fmt.Fprintf(s, "either.Right[%s, %s]("+inner+")", l, r, e.right)
+		}
+
+		return
+	}
+
+	if e.isLeft {
+		fmt.Fprintf(s, "Left("+inner+")", e.left)
+	} else {
+		fmt.Fprintf(s, "Right("+inner+")", e.right)
+	}
+}
@@ -0,0 +1,35 @@
+package either
+
+import "iter"
+
+func Lefts[L, R any](seq iter.Seq[Either[L, R]]) iter.Seq[L] {
+	return func(yield func(L) bool) {
+		for e := range seq {
+			if e.isLeft && !yield(e.left) {
+				return
+			}
+		}
+	}
+}
+
+func Rights[L, R any](seq iter.Seq[Either[L, R]]) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for e := range seq {
+			if !e.isLeft && !yield(e.right) {
+				return
+			}
+		}
+	}
+}
+
+func PartitionSeq[L, R any](seq iter.Seq[Either[L, R]]) (lefts []L, rights []R) {
+	for e := range seq {
+		if e.isLeft {
+			lefts = append(lefts, e.left)
+		} else {
+			rights = append(rights, e.right)
+		}
+	}
+
+	return lefts, rights
+}
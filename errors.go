@@ -8,3 +8,9 @@ func (e ResultError) Error() string {
 }
 
 const ErrNoResults ResultError = "result: no results provided"
+
+const (
+	ErrZeroResult        ResultError = "result: zero value Result"
+	ErrInvalidResultJSON ResultError = "result: invalid JSON envelope"
+	ErrUnknownErrorCode  ResultError = "result: unknown error code"
+)
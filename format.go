@@ -0,0 +1,73 @@
+package gofp
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
+
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
+
+func (r Result[T]) LogValue() slog.Value {
+	if r.ok {
+		return slog.GroupValue(slog.Any("ok", r.value))
+	}
+
+	return slog.GroupValue(slog.Any("err", r.err))
+}
+
+func (r Result[T]) String() string {
+	return fmt.Sprint(r)
+}
+
+func (r Result[T]) GoString() string {
+	return fmt.Sprintf("%#v", r)
+}
+
+func (r Result[T]) Format(s fmt.State, verb rune) {
+	inner := fmt.FormatString(s, verb)
+
+	switch {
+	case verb == 'v' && s.Flag('#') && r.ok:
+		fmt.Fprintf(s, "gofp.Ok[%s]("+inner+")", typeName[T](), r.value)
+	case verb == 'v' && s.Flag('#'):
+		fmt.Fprintf(s, "gofp.Err[%s]("+inner+")", typeName[T](), r.err)
+	case r.ok:
+		fmt.Fprintf(s, "Ok("+inner+")", r.value)
+	default:
+		fmt.Fprintf(s, "Err("+inner+")", r.err)
+	}
+}
+
+func (o Option[T]) LogValue() slog.Value {
+	if o.ok {
+		return slog.AnyValue(o.value)
+	}
+
+	return slog.StringValue("None")
+}
+
+func (o Option[T]) String() string {
+	return fmt.Sprint(o)
+}
+
+func (o Option[T]) GoString() string {
+	return fmt.Sprintf("%#v", o)
+}
+
+func (o Option[T]) Format(s fmt.State, verb rune) {
+	inner := fmt.FormatString(s, verb)
+
+	switch {
+	case verb == 'v' && s.Flag('#') && o.ok:
+		fmt.Fprintf(s, "gofp.Some[%s]("+inner+")", typeName[T](), o.value)
+	case verb == 'v' && s.Flag('#'):
+		fmt.Fprintf(s, "gofp.None[%s]()", typeName[T]())
+	case o.ok:
+		fmt.Fprintf(s, "Some("+inner+")", o.value)
+	default:
+		fmt.Fprint(s, "None")
+	}
+}
@@ -0,0 +1,143 @@
+package gofptest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/either"
+)
+
+func AssertOk[T any](t testing.TB, r gofp.Result[T]) T {
+	t.Helper()
+
+	if r.IsErr() {
+		t.Fatalf("gofptest.AssertOk: got %v, want Ok", r)
+	}
+
+	return r.UnwrapOrZero()
+}
+
+func AssertOkEqual[T any](t testing.TB, r gofp.Result[T], want T) T {
+	t.Helper()
+
+	got := AssertOk(t, r)
+	if d := Diff(want, got); d != "" {
+		t.Fatalf("gofptest.AssertOkEqual: Ok value mismatch (-want +got):\n%s", d)
+	}
+
+	return got
+}
+
+func AssertErr[T any](t testing.TB, r gofp.Result[T]) error {
+	t.Helper()
+
+	if r.IsOk() {
+		t.Fatalf("gofptest.AssertErr: got %v, want Err", r)
+	}
+
+	return r.IntoErr()
+}
+
+func AssertErrIs[T any](t testing.TB, r gofp.Result[T], target error) error {
+	t.Helper()
+
+	err := AssertErr(t, r)
+	if !errors.Is(err, target) {
+		t.Fatalf("gofptest.AssertErrIs: got Err(%v), want an error matching %v", err, target)
+	}
+
+	return err
+}
+
+func AssertErrAs[E error, T any](t testing.TB, r gofp.Result[T]) E {
+	t.Helper()
+
+	var target E
+	if err := AssertErr(t, r); !errors.As(err, &target) {
+		t.Fatalf("gofptest.AssertErrAs: got Err(%v), want an error of type %T", err, target)
+	}
+
+	return target
+}
+
+func AssertSome[T any](t testing.TB, o gofp.Option[T]) T {
+	t.Helper()
+
+	if o.IsNone() {
+		t.Fatalf("gofptest.AssertSome: got None, want Some")
+	}
+
+	return o.UnwrapOrZero()
+}
+
+func AssertSomeEqual[T any](t testing.TB, o gofp.Option[T], want T) T {
+	t.Helper()
+
+	got := AssertSome(t, o)
+	if d := Diff(want, got); d != "" {
+		t.Fatalf("gofptest.AssertSomeEqual: Some value mismatch (-want +got):\n%s", d)
+	}
+
+	return got
+}
+
+func AssertNone[T any](t testing.TB, o gofp.Option[T]) {
+	t.Helper()
+
+	if o.IsSome() {
+		t.Fatalf("gofptest.AssertNone: got %v, want None", o)
+	}
+}
+
+func AssertLeft[L, R any](t testing.TB, e either.Either[L, R]) L {
+	t.Helper()
+
+	if e.IsRight() {
+		t.Fatalf("gofptest.AssertLeft: got %v, want Left", e)
+	}
+
+	var zero L
+	return e.UnwrapLeftOr(zero)
+}
+
+func AssertLeftEqual[L, R any](t testing.TB, e either.Either[L, R], want L) L {
+	t.Helper()
+
+	got := AssertLeft(t, e)
+	if d := Diff(want, got); d != "" {
+		t.Fatalf("gofptest.AssertLeftEqual: Left value mismatch (-want +got):\n%s", d)
+	}
+
+	return got
+}
+
+func AssertRight[L, R any](t testing.TB, e either.Either[L, R]) R {
+	t.Helper()
+
+	if e.IsLeft() {
+		t.Fatalf("gofptest.AssertRight: got %v, want Right", e)
+	}
+
+	var zero R
+	return e.UnwrapRightOr(zero)
+}
+
+func AssertRightEqual[L, R any](t testing.TB, e either.Either[L, R], want R) R {
+	t.Helper()
+
+	got := AssertRight(t, e)
+	if d := Diff(want, got); d != "" {
+		t.Fatalf("gofptest.AssertRightEqual: Right value mismatch (-want +got):\n%s", d)
+	}
+
+	return got
+}
+
+func AssertEqual[T any](t testing.TB, got, want T) {
+	t.Helper()
+
+	if d := Diff(want, got); d != "" {
+		t.Fatalf("gofptest.AssertEqual: mismatch (-want +got):\n%s", d)
+	}
+}
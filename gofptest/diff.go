@@ -0,0 +1,218 @@
+package gofptest
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+func Diff(want, got any) string {
+	if reflect.DeepEqual(want, got) {
+		return ""
+	}
+
+	w := strings.Split(pretty(want), "\n")
+	g := strings.Split(pretty(got), "\n")
+
+	lines := diffLines(w, g)
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	if !slices.ContainsFunc(lines, changed) {
+		b.WriteString("(values differ under reflect.DeepEqual but print identically; check for func values, NaN or unexported state)\n")
+	}
+
+	return b.String()
+}
+
+func changed(line string) bool {
+	return !strings.HasPrefix(line, "  ")
+}
+
+func diffLines(want, got []string) []string {
+	lcs := make([][]int, len(want)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(got)+1)
+	}
+	for i := len(want) - 1; i >= 0; i-- {
+		for j := len(got) - 1; j >= 0; j-- {
+			if want[i] == got[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(want) || j < len(got) {
+		switch {
+		case i < len(want) && j < len(got) && want[i] == got[j]:
+			out = append(out, "  "+want[i])
+			i++
+			j++
+		case i < len(want) && (j == len(got) || lcs[i+1][j] >= lcs[i][j+1]):
+			out = append(out, "- "+want[i])
+			i++
+		default:
+			out = append(out, "+ "+got[j])
+			j++
+		}
+	}
+
+	return out
+}
+
+type visit struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+type printer struct {
+	b       strings.Builder
+	visited map[visit]bool
+}
+
+func pretty(v any) string {
+	p := printer{visited: map[visit]bool{}}
+	p.writeValue(reflect.ValueOf(v), 0)
+
+	return p.b.String()
+}
+
+func (p *printer) enter(v reflect.Value) bool {
+	key := visit{typ: v.Type(), ptr: v.Pointer()}
+	if p.visited[key] {
+		p.b.WriteString("<cycle>")
+		return false
+	}
+
+	p.visited[key] = true
+	return true
+}
+
+func (p *printer) leave(v reflect.Value) {
+	delete(p.visited, visit{typ: v.Type(), ptr: v.Pointer()})
+}
+
+func (p *printer) writeValue(v reflect.Value, depth int) {
+	b := &p.b
+
+	if !v.IsValid() {
+		b.WriteString("nil")
+		return
+	}
+
+	if v.CanInterface() {
+		switch v.Interface().(type) {
+		case fmt.GoStringer, error:
+			fmt.Fprintf(b, "%#v", v.Interface())
+			return
+		}
+	}
+
+	indent := strings.Repeat("\t", depth+1)
+	closing := strings.Repeat("\t", depth)
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			fmt.Fprintf(b, "(%s)(nil)", v.Type())
+			return
+		}
+
+		if !p.enter(v) {
+			return
+		}
+		defer p.leave(v)
+
+		b.WriteByte('&')
+		p.writeValue(v.Elem(), depth)
+	case reflect.Interface:
+		p.writeValue(v.Elem(), depth)
+	case reflect.Struct:
+		if v.NumField() == 0 {
+			fmt.Fprintf(b, "%s{}", v.Type())
+			return
+		}
+
+		fmt.Fprintf(b, "%s{\n", v.Type())
+		for i := range v.NumField() {
+			fmt.Fprintf(b, "%s%s: ", indent, v.Type().Field(i).Name)
+			p.writeValue(v.Field(i), depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(closing + "}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			fmt.Fprintf(b, "%s(nil)", v.Type())
+			return
+		}
+		if v.Len() == 0 {
+			fmt.Fprintf(b, "%s{}", v.Type())
+			return
+		}
+		if v.Kind() == reflect.Slice {
+			if !p.enter(v) {
+				return
+			}
+			defer p.leave(v)
+		}
+
+		fmt.Fprintf(b, "%s{\n", v.Type())
+		for i := range v.Len() {
+			b.WriteString(indent)
+			p.writeValue(v.Index(i), depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(closing + "}")
+	case reflect.Map:
+		if v.IsNil() {
+			fmt.Fprintf(b, "%s(nil)", v.Type())
+			return
+		}
+		if v.Len() == 0 {
+			fmt.Fprintf(b, "%s{}", v.Type())
+			return
+		}
+
+		if !p.enter(v) {
+			return
+		}
+		defer p.leave(v)
+
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(x, y reflect.Value) int {
+			return strings.Compare(fmt.Sprint(x), fmt.Sprint(y))
+		})
+
+		fmt.Fprintf(b, "%s{\n", v.Type())
+		for _, k := range keys {
+			b.WriteString(indent)
+			p.writeValue(k, depth+1)
+			b.WriteString(": ")
+			p.writeValue(v.MapIndex(k), depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(closing + "}")
+	case reflect.String:
+		fmt.Fprintf(b, "%q", v.String())
+	case reflect.Bool:
+		fmt.Fprintf(b, "%t", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Fprintf(b, "%d", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		fmt.Fprintf(b, "%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		fmt.Fprintf(b, "%v", v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		fmt.Fprintf(b, "%v", v.Complex())
+	default:
+		fmt.Fprintf(b, "%s(%v)", v.Type(), v)
+	}
+}
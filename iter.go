@@ -0,0 +1,19 @@
+package gofp
+
+import "iter"
+
+func (o Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.ok {
+			yield(o.value)
+		}
+	}
+}
+
+func (r Result[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if r.ok {
+			yield(r.value)
+		}
+	}
+}
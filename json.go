@@ -0,0 +1,117 @@
+package gofp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+var jsonNull = []byte("null")
+
+func (o Option[T]) IsZero() bool { return !o.ok }
+
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return jsonNull, nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*o = Some(value)
+	return nil
+}
+
+func (p Patch[T]) IsZero() bool { return !p.set }
+
+func (p Patch[T]) MarshalJSON() ([]byte, error) {
+	return p.value.MarshalJSON()
+}
+
+func (p *Patch[T]) UnmarshalJSON(data []byte) error {
+	var value Option[T]
+	if err := value.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	*p = Present(value)
+	return nil
+}
+
+func (r Result[T]) IsZero() bool { return !r.ok && r.err == nil }
+
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return jsonNull, nil
+	}
+
+	enc := resultEncoding.Load()
+
+	if r.ok {
+		value, err := json.Marshal(r.value)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(map[string]json.RawMessage{enc.OkKey: value})
+	}
+
+	data, err := enc.Errors.EncodeError(r.err)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]json.RawMessage{enc.ErrKey: data})
+}
+
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*r = Result[T]{}
+		return nil
+	}
+
+	enc := resultEncoding.Load()
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	okData, hasOk := fields[enc.OkKey]
+	errData, hasErr := fields[enc.ErrKey]
+	if hasOk == hasErr {
+		return fmt.Errorf("%w: expected exactly one of %q or %q", ErrInvalidResultJSON, enc.OkKey, enc.ErrKey)
+	}
+
+	if hasOk {
+		var value T
+		if err := json.Unmarshal(okData, &value); err != nil {
+			return err
+		}
+
+		*r = Ok(value)
+		return nil
+	}
+
+	decoded, err := enc.Errors.DecodeError(errData)
+	if err != nil {
+		return err
+	}
+	if decoded == nil {
+		return fmt.Errorf("%w: %q decoded to a nil error", ErrInvalidResultJSON, enc.ErrKey)
+	}
+
+	*r = Err[T](decoded)
+	return nil
+}
@@ -0,0 +1,87 @@
+package laws
+
+import (
+	"fmt"
+	"hash/fnv"
+	"iter"
+	"math/rand/v2"
+
+	"github.com/Alsond5/gofp/prop"
+)
+
+const arrowSize = 8
+
+type Fn uint64
+
+func (f Fn) String() string { return fmt.Sprintf("fn#%x", uint64(f)) }
+
+func (f Fn) GoString() string { return f.String() }
+
+type arrows[M, T any] struct {
+	genM prop.Gen[M]
+	genT prop.Gen[T]
+}
+
+func (a arrows[M, T]) endo(f Fn) func(T) T {
+	return func(x T) T {
+		return a.genT.Generate(arrowRand(f, x), arrowSize)
+	}
+}
+
+func (a arrows[M, T]) kleisli(f Fn) func(T) M {
+	return func(x T) M {
+		return a.genM.Generate(arrowRand(f, x), arrowSize)
+	}
+}
+
+func (a arrows[M, T]) binary(f Fn) func(T, T) T {
+	return func(x, y T) T {
+		return a.genT.Generate(arrowRand(f, x, y), arrowSize)
+	}
+}
+
+func arrowRand(f Fn, args ...any) *rand.Rand {
+	h := fnv.New64a()
+	for _, arg := range args {
+		fmt.Fprintf(h, "%#v\x00", arg)
+	}
+
+	return prop.NewRand(uint64(f) ^ h.Sum64())
+}
+
+func caseGen[M, T any](genM prop.Gen[M], genT prop.Gen[T]) prop.Gen[Case[M, T]] {
+	return prop.Gen[Case[M, T]]{
+		Generate: func(r *rand.Rand, size int) Case[M, T] {
+			return Case[M, T]{
+				M: genM.Generate(r, size),
+				N: genM.Generate(r, size),
+				O: genM.Generate(r, size),
+				A: genT.Generate(r, size),
+				B: genT.Generate(r, size),
+				F: Fn(r.Uint64()),
+				G: Fn(r.Uint64()),
+			}
+		},
+		Shrink: func(c Case[M, T]) iter.Seq[Case[M, T]] {
+			return func(yield func(Case[M, T]) bool) {
+				_ = shrinkField(c, genM, func(c *Case[M, T]) *M { return &c.M }, yield) &&
+					shrinkField(c, genM, func(c *Case[M, T]) *M { return &c.N }, yield) &&
+					shrinkField(c, genM, func(c *Case[M, T]) *M { return &c.O }, yield) &&
+					shrinkField(c, genT, func(c *Case[M, T]) *T { return &c.A }, yield) &&
+					shrinkField(c, genT, func(c *Case[M, T]) *T { return &c.B }, yield)
+			}
+		},
+	}
+}
+
+func shrinkField[M, T, V any](c Case[M, T], g prop.Gen[V], field func(*Case[M, T]) *V, yield func(Case[M, T]) bool) bool {
+	for v := range g.Shrinks(*field(&c)) {
+		next := c
+		*field(&next) = v
+		if !yield(next) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,62 @@
+package laws
+
+import (
+	"testing"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/either"
+	"github.com/Alsond5/gofp/option"
+	"github.com/Alsond5/gofp/prop"
+	"github.com/Alsond5/gofp/result"
+	"github.com/Alsond5/gofp/tuple"
+)
+
+func Option[T any]() Instance[gofp.Option[T], T] {
+	return Instance[gofp.Option[T], T]{
+		Pure:    gofp.Some[T],
+		Map:     option.Map[T, T],
+		FlatMap: option.FlatMap[T, T],
+		ZipWith: option.ZipWith[T, T, T],
+	}
+}
+
+func Result[T any]() Instance[gofp.Result[T], T] {
+	return Instance[gofp.Result[T], T]{
+		Pure:    gofp.Ok[T],
+		Map:     result.Map[T, T],
+		FlatMap: result.FlatMap[T, T],
+		ZipWith: func(a, b gofp.Result[T], f func(T, T) T) gofp.Result[T] {
+			return result.Map(result.All2(a, b), func(p tuple.Pair[T, T]) T {
+				return f(p.First, p.Second)
+			})
+		},
+	}
+}
+
+func Either[L, T any]() Instance[either.Either[L, T], T] {
+	return Instance[either.Either[L, T], T]{
+		Pure:    either.Right[L, T],
+		Map:     either.MapRight[L, T, T],
+		FlatMap: either.FlatMapRight[L, T, T],
+		ZipWith: func(a, b either.Either[L, T], f func(T, T) T) either.Either[L, T] {
+			return either.FlatMapRight(a, func(x T) either.Either[L, T] {
+				return either.MapRight(b, func(y T) T { return f(x, y) })
+			})
+		},
+	}
+}
+
+func CheckOption[T any](t *testing.T, cfg prop.Config, genT prop.Gen[T]) {
+	t.Helper()
+	Check(t, cfg, Option[T](), prop.Option(genT), genT)
+}
+
+func CheckResult[T any](t *testing.T, cfg prop.Config, genT prop.Gen[T]) {
+	t.Helper()
+	Check(t, cfg, Result[T](), prop.Result(genT, prop.Error()), genT)
+}
+
+func CheckEither[L, T any](t *testing.T, cfg prop.Config, genL prop.Gen[L], genT prop.Gen[T]) {
+	t.Helper()
+	Check(t, cfg, Either[L, T](), prop.Either(genL, genT), genT)
+}
@@ -0,0 +1,121 @@
+package laws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Alsond5/gofp/prop"
+)
+
+type Instance[M, T any] struct {
+	Pure    func(T) M
+	Map     func(M, func(T) T) M
+	FlatMap func(M, func(T) M) M
+	ZipWith func(M, M, func(T, T) T) M
+	Equal   func(M, M) bool
+}
+
+type Case[M, T any] struct {
+	M, N, O M
+	A, B    T
+	F, G    Fn
+}
+
+type law[M, T any] struct {
+	name  string
+	check func(c Case[M, T], fn arrows[M, T]) (M, M)
+}
+
+func Check[M, T any](t *testing.T, cfg prop.Config, inst Instance[M, T], genM prop.Gen[M], genT prop.Gen[T]) {
+	t.Helper()
+
+	equal := inst.Equal
+	if equal == nil {
+		equal = func(a, b M) bool { return reflect.DeepEqual(a, b) }
+	}
+
+	fn := arrows[M, T]{genM: genM, genT: genT}
+	gen := caseGen(genM, genT)
+
+	for _, l := range inst.laws() {
+		t.Run(l.name, func(t *testing.T) {
+			t.Helper()
+
+			prop.ForAll(t, cfg, gen, func(c Case[M, T]) error {
+				lhs, rhs := l.check(c, fn)
+				if !equal(lhs, rhs) {
+					return fmt.Errorf("%s: %#v != %#v", l.name, lhs, rhs)
+				}
+
+				return nil
+			})
+		})
+	}
+}
+
+func (inst Instance[M, T]) laws() []law[M, T] {
+	var laws []law[M, T]
+
+	if inst.Map != nil {
+		laws = append(laws,
+			law[M, T]{"functor/identity", func(c Case[M, T], _ arrows[M, T]) (M, M) {
+				return inst.Map(c.M, func(x T) T { return x }), c.M
+			}},
+			law[M, T]{"functor/composition", func(c Case[M, T], fn arrows[M, T]) (M, M) {
+				f, g := fn.endo(c.F), fn.endo(c.G)
+				return inst.Map(c.M, func(x T) T { return g(f(x)) }), inst.Map(inst.Map(c.M, f), g)
+			}},
+		)
+	}
+
+	if inst.FlatMap != nil && inst.Pure != nil {
+		laws = append(laws,
+			law[M, T]{"monad/left-identity", func(c Case[M, T], fn arrows[M, T]) (M, M) {
+				k := fn.kleisli(c.F)
+				return inst.FlatMap(inst.Pure(c.A), k), k(c.A)
+			}},
+			law[M, T]{"monad/right-identity", func(c Case[M, T], _ arrows[M, T]) (M, M) {
+				return inst.FlatMap(c.M, inst.Pure), c.M
+			}},
+		)
+	}
+
+	if inst.FlatMap != nil {
+		laws = append(laws,
+			law[M, T]{"monad/associativity", func(c Case[M, T], fn arrows[M, T]) (M, M) {
+				k, h := fn.kleisli(c.F), fn.kleisli(c.G)
+				return inst.FlatMap(inst.FlatMap(c.M, k), h), inst.FlatMap(c.M, func(x T) M { return inst.FlatMap(k(x), h) })
+			}},
+		)
+	}
+
+	if inst.ZipWith != nil && inst.Pure != nil {
+		laws = append(laws,
+			law[M, T]{"applicative/identity", func(c Case[M, T], _ arrows[M, T]) (M, M) {
+				return inst.ZipWith(c.M, inst.Pure(c.A), func(x, _ T) T { return x }), c.M
+			}},
+			law[M, T]{"applicative/homomorphism", func(c Case[M, T], fn arrows[M, T]) (M, M) {
+				f := fn.binary(c.F)
+				return inst.ZipWith(inst.Pure(c.A), inst.Pure(c.B), f), inst.Pure(f(c.A, c.B))
+			}},
+		)
+	}
+
+	if inst.ZipWith != nil && inst.Map != nil {
+		laws = append(laws,
+			law[M, T]{"applicative/naturality", func(c Case[M, T], fn arrows[M, T]) (M, M) {
+				f, g := fn.binary(c.F), fn.endo(c.G)
+				return inst.Map(inst.ZipWith(c.M, c.N, f), g), inst.ZipWith(c.M, c.N, func(x, y T) T { return g(f(x, y)) })
+			}},
+			law[M, T]{"applicative/associativity", func(c Case[M, T], fn arrows[M, T]) (M, M) {
+				f := fn.binary(c.F)
+				first := func(x, _ T) T { return x }
+				second := func(_, y T) T { return y }
+				return inst.ZipWith(inst.ZipWith(c.M, c.N, first), c.O, f), inst.ZipWith(c.M, inst.ZipWith(c.N, c.O, second), f)
+			}},
+		)
+	}
+
+	return laws
+}
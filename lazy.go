@@ -0,0 +1,56 @@
+package gofp
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Lazy[T any] struct {
+	mu       sync.Mutex
+	done     atomic.Bool
+	init     func() Result[T]
+	result   Result[T]
+	retryErr bool
+}
+
+func NewLazy[T any](init func() Result[T]) *Lazy[T] {
+	return &Lazy[T]{init: init}
+}
+
+func NewLazyRetry[T any](init func() Result[T]) *Lazy[T] {
+	return &Lazy[T]{init: init, retryErr: true}
+}
+
+func (l *Lazy[T]) Get() Result[T] {
+	if l.done.Load() {
+		return l.result
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.done.Load() {
+		return l.result
+	}
+
+	r := Try(func() T { return l.init().Unwrap() })
+	if r.IsErr() && l.retryErr {
+		return r
+	}
+
+	l.result = r
+	l.init = nil
+	l.done.Store(true)
+
+	return r
+}
+
+func (l *Lazy[T]) Peek() Option[T] {
+	if !l.done.Load() {
+		return None[T]()
+	}
+
+	return l.result.Ok()
+}
+
+func (l *Lazy[T]) IsEvaluated() bool { return l.done.Load() }
@@ -29,6 +29,62 @@ func Zip[T, U any](o gofp.Option[T], other gofp.Option[U]) gofp.Option[tuple.Pai
 	return gofp.None[tuple.Pair[T, U]]()
 }
 
+func Zip3[A, B, C any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C]) gofp.Option[tuple.Triple[A, B, C]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() {
+		return gofp.Some(tuple.Triple[A, B, C]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap()})
+	}
+
+	return gofp.None[tuple.Triple[A, B, C]]()
+}
+
+func Zip4[A, B, C, D any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C], d gofp.Option[D]) gofp.Option[tuple.Tuple4[A, B, C, D]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() && d.IsSome() {
+		return gofp.Some(tuple.Tuple4[A, B, C, D]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap()})
+	}
+
+	return gofp.None[tuple.Tuple4[A, B, C, D]]()
+}
+
+func Zip5[A, B, C, D, E any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C], d gofp.Option[D], e gofp.Option[E]) gofp.Option[tuple.Tuple5[A, B, C, D, E]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() && d.IsSome() && e.IsSome() {
+		return gofp.Some(tuple.Tuple5[A, B, C, D, E]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap()})
+	}
+
+	return gofp.None[tuple.Tuple5[A, B, C, D, E]]()
+}
+
+func Zip6[A, B, C, D, E, F any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C], d gofp.Option[D], e gofp.Option[E], f gofp.Option[F]) gofp.Option[tuple.Tuple6[A, B, C, D, E, F]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() && d.IsSome() && e.IsSome() && f.IsSome() {
+		return gofp.Some(tuple.Tuple6[A, B, C, D, E, F]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap()})
+	}
+
+	return gofp.None[tuple.Tuple6[A, B, C, D, E, F]]()
+}
+
+func Zip7[A, B, C, D, E, F, G any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C], d gofp.Option[D], e gofp.Option[E], f gofp.Option[F], g gofp.Option[G]) gofp.Option[tuple.Tuple7[A, B, C, D, E, F, G]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() && d.IsSome() && e.IsSome() && f.IsSome() && g.IsSome() {
+		return gofp.Some(tuple.Tuple7[A, B, C, D, E, F, G]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap(), Seventh: g.Unwrap()})
+	}
+
+	return gofp.None[tuple.Tuple7[A, B, C, D, E, F, G]]()
+}
+
+func Zip8[A, B, C, D, E, F, G, H any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C], d gofp.Option[D], e gofp.Option[E], f gofp.Option[F], g gofp.Option[G], h gofp.Option[H]) gofp.Option[tuple.Tuple8[A, B, C, D, E, F, G, H]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() && d.IsSome() && e.IsSome() && f.IsSome() && g.IsSome() && h.IsSome() {
+		return gofp.Some(tuple.Tuple8[A, B, C, D, E, F, G, H]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap(), Seventh: g.Unwrap(), Eighth: h.Unwrap()})
+	}
+
+	return gofp.None[tuple.Tuple8[A, B, C, D, E, F, G, H]]()
+}
+
+func Zip9[A, B, C, D, E, F, G, H, I any](a gofp.Option[A], b gofp.Option[B], c gofp.Option[C], d gofp.Option[D], e gofp.Option[E], f gofp.Option[F], g gofp.Option[G], h gofp.Option[H], i gofp.Option[I]) gofp.Option[tuple.Tuple9[A, B, C, D, E, F, G, H, I]] {
+	if a.IsSome() && b.IsSome() && c.IsSome() && d.IsSome() && e.IsSome() && f.IsSome() && g.IsSome() && h.IsSome() && i.IsSome() {
+		return gofp.Some(tuple.Tuple9[A, B, C, D, E, F, G, H, I]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap(), Seventh: g.Unwrap(), Eighth: h.Unwrap(), Ninth: i.Unwrap()})
+	}
+
+	return gofp.None[tuple.Tuple9[A, B, C, D, E, F, G, H, I]]()
+}
+
 func ZipWith[T, U, R any](o gofp.Option[T], other gofp.Option[U], f func(T, U) R) gofp.Option[R] {
 	if o.IsSome() && other.IsSome() {
 		return gofp.Some(f(o.Unwrap(), other.Unwrap()))
@@ -0,0 +1,40 @@
+package option
+
+import (
+	"iter"
+
+	"github.com/Alsond5/gofp"
+)
+
+func Collect[T any](seq iter.Seq[gofp.Option[T]]) gofp.Option[[]T] {
+	values := make([]T, 0)
+	for o := range seq {
+		if o.IsNone() {
+			return gofp.None[[]T]()
+		}
+
+		values = append(values, o.Unwrap())
+	}
+
+	return gofp.Some(values)
+}
+
+func Values[T any](seq iter.Seq[gofp.Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.IsSome() && !yield(o.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+func FilterMap[T, U any](seq iter.Seq[T], f func(T) gofp.Option[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if o := f(v); o.IsSome() && !yield(o.Unwrap()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package gofp
+
+type Patch[T any] struct {
+	value Option[T]
+	set   bool
+}
+
+func Present[T any](value Option[T]) Patch[T] {
+	return Patch[T]{value: value, set: true}
+}
+
+func Set[T any](value T) Patch[T] {
+	return Present(Some(value))
+}
+
+func Null[T any]() Patch[T] {
+	return Present(None[T]())
+}
+
+func Absent[T any]() Patch[T] {
+	return Patch[T]{}
+}
+
+func (p Patch[T]) IsSet() bool { return p.set }
+
+func (p Patch[T]) IsAbsent() bool { return !p.set }
+
+func (p Patch[T]) IsNull() bool { return p.set && !p.value.ok }
+
+func (p Patch[T]) IsValue() bool { return p.set && p.value.ok }
+
+func (p Patch[T]) Option() Option[T] {
+	return p.value
+}
+
+func (p Patch[T]) Get() (Option[T], bool) {
+	return p.value, p.set
+}
+
+func (p Patch[T]) Apply(current Option[T]) Option[T] {
+	if !p.set {
+		return current
+	}
+
+	return p.value
+}
+
+func (p Patch[T]) IfSet(f func(Option[T])) Patch[T] {
+	if p.set {
+		f(p.value)
+	}
+
+	return p
+}
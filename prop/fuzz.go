@@ -0,0 +1,59 @@
+package prop
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand/v2"
+	"testing"
+)
+
+const fuzzCorpus = 8
+
+type byteSource struct {
+	data     []byte
+	fallback *rand.PCG
+}
+
+func (s *byteSource) Uint64() uint64 {
+	if len(s.data) == 0 {
+		return s.fallback.Uint64()
+	}
+
+	var buf [8]byte
+	n := copy(buf[:], s.data)
+	s.data = s.data[n:]
+
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+func FromBytes[T any](g Gen[T], data []byte, size int) T {
+	h := fnv.New64a()
+	h.Write(data)
+	seed := h.Sum64()
+
+	src := &byteSource{data: data, fallback: rand.NewPCG(seed, uint64(len(data)))}
+
+	return g.Generate(rand.New(src), size)
+}
+
+func Fuzz[T any](f *testing.F, cfg Config, g Gen[T], property func(T) error) {
+	f.Helper()
+
+	cfg = cfg.withDefaults()
+	r := NewRand(cfg.Seed)
+
+	for range fuzzCorpus {
+		data := make([]byte, 8*cfg.MaxSize)
+		for i := range data {
+			data[i] = byte(r.Uint32())
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v := FromBytes(g, data, cfg.MaxSize)
+		if err := check(property, v); err != nil {
+			t.Fatalf("counterexample: %#v\nerror: %v", v, err)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+package prop
+
+import (
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+const printable = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 _-.,:;!?/"
+
+func Const[T any](value T) Gen[T] {
+	return Gen[T]{
+		Generate: func(*rand.Rand, int) T { return value },
+	}
+}
+
+func Int(lo, hi int) Gen[int] {
+	if lo > hi {
+		panic("prop: Int lower bound greater than upper bound")
+	}
+
+	span := uint64(hi) - uint64(lo)
+
+	return Gen[int]{
+		Generate: func(r *rand.Rand, _ int) int {
+			if span == math.MaxUint64 {
+				return int(r.Uint64())
+			}
+
+			return lo + int(r.Uint64N(span+1))
+		},
+		Shrink: func(v int) iter.Seq[int] {
+			return shrinkInt(v, min(max(0, lo), hi))
+		},
+	}
+}
+
+func SizedInt() Gen[int] {
+	return Gen[int]{
+		Generate: func(r *rand.Rand, size int) int {
+			return r.IntN(2*size+1) - size
+		},
+		Shrink: func(v int) iter.Seq[int] {
+			return shrinkInt(v, 0)
+		},
+	}
+}
+
+func Bool() Gen[bool] {
+	return Gen[bool]{
+		Generate: func(r *rand.Rand, _ int) bool {
+			return r.IntN(2) == 1
+		},
+		Shrink: func(v bool) iter.Seq[bool] {
+			return func(yield func(bool) bool) {
+				if v {
+					yield(false)
+				}
+			}
+		},
+	}
+}
+
+func Elements[T comparable](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("prop: Elements requires at least one value")
+	}
+
+	return Gen[T]{
+		Generate: func(r *rand.Rand, _ int) T {
+			return values[r.IntN(len(values))]
+		},
+		Shrink: func(v T) iter.Seq[T] {
+			return func(yield func(T) bool) {
+				for _, candidate := range values[:max(0, slices.Index(values, v))] {
+					if !yield(candidate) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func OneOf[T any](gens ...Gen[T]) Gen[T] {
+	if len(gens) == 0 {
+		panic("prop: OneOf requires at least one generator")
+	}
+
+	return Gen[T]{
+		Generate: func(r *rand.Rand, size int) T {
+			return gens[r.IntN(len(gens))].Generate(r, size)
+		},
+		Shrink: func(v T) iter.Seq[T] {
+			return func(yield func(T) bool) {
+				for _, g := range gens {
+					for candidate := range g.Shrinks(v) {
+						if !yield(candidate) {
+							return
+						}
+					}
+				}
+			}
+		},
+	}
+}
+
+func Map[T, U any](g Gen[T], f func(T) U) Gen[U] {
+	return Gen[U]{
+		Generate: func(r *rand.Rand, size int) U {
+			return f(g.Generate(r, size))
+		},
+	}
+}
+
+func Filter[T any](g Gen[T], pred func(T) bool) Gen[T] {
+	return Gen[T]{
+		Generate: func(r *rand.Rand, size int) T {
+			for range 1000 {
+				if v := g.Generate(r, size); pred(v) {
+					return v
+				}
+			}
+			panic("prop: Filter discarded 1000 consecutive values")
+		},
+		Shrink: func(v T) iter.Seq[T] {
+			return func(yield func(T) bool) {
+				for candidate := range g.Shrinks(v) {
+					if pred(candidate) && !yield(candidate) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func String() Gen[string] {
+	return StringOf(printable)
+}
+
+func StringOf(alphabet string) Gen[string] {
+	runes := []rune(alphabet)
+	if len(runes) == 0 {
+		panic("prop: StringOf requires a non-empty alphabet")
+	}
+
+	g := SliceOf(Elements(runes...))
+
+	return Gen[string]{
+		Generate: func(r *rand.Rand, size int) string {
+			return string(g.Generate(r, size))
+		},
+		Shrink: func(s string) iter.Seq[string] {
+			return func(yield func(string) bool) {
+				for candidate := range g.Shrinks([]rune(s)) {
+					if !yield(string(candidate)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func SliceOf[T any](g Gen[T]) Gen[[]T] {
+	return Gen[[]T]{
+		Generate: func(r *rand.Rand, size int) []T {
+			s := make([]T, r.IntN(size+1))
+			for i := range s {
+				s[i] = g.Generate(r, size)
+			}
+
+			return s
+		},
+		Shrink: func(s []T) iter.Seq[[]T] {
+			return shrinkSlice(g, s)
+		},
+	}
+}
+
+func shrinkInt(v, target int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if v == target {
+			return
+		}
+		if !yield(target) {
+			return
+		}
+
+		for diff := (v - target) / 2; diff != 0; diff /= 2 {
+			if !yield(v - diff) {
+				return
+			}
+		}
+	}
+}
+
+func shrinkSlice[T any](g Gen[T], s []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for chunk := len(s); chunk > 0; chunk /= 2 {
+			for i := 0; i+chunk <= len(s); i += chunk {
+				if !yield(slices.Delete(slices.Clone(s), i, i+chunk)) {
+					return
+				}
+			}
+		}
+
+		for i, v := range s {
+			for candidate := range g.Shrinks(v) {
+				next := slices.Clone(s)
+				next[i] = candidate
+				if !yield(next) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package prop
+
+import (
+	"iter"
+	"math/rand/v2"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/either"
+)
+
+func Option[T any](g Gen[T]) Gen[gofp.Option[T]] {
+	return Gen[gofp.Option[T]]{
+		Generate: func(r *rand.Rand, size int) gofp.Option[T] {
+			if r.IntN(4) == 0 {
+				return gofp.None[T]()
+			}
+
+			return gofp.Some(g.Generate(r, size))
+		},
+		Shrink: func(o gofp.Option[T]) iter.Seq[gofp.Option[T]] {
+			return func(yield func(gofp.Option[T]) bool) {
+				if o.IsNone() || !yield(gofp.None[T]()) {
+					return
+				}
+
+				for v := range g.Shrinks(o.Unwrap()) {
+					if !yield(gofp.Some(v)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func Error() Gen[error] {
+	g := String()
+
+	return Gen[error]{
+		Generate: func(r *rand.Rand, size int) error {
+			return gofp.ResultError(g.Generate(r, size))
+		},
+		Shrink: func(err error) iter.Seq[error] {
+			return func(yield func(error) bool) {
+				msg, ok := err.(gofp.ResultError)
+				if !ok {
+					return
+				}
+
+				for candidate := range g.Shrinks(string(msg)) {
+					if !yield(gofp.ResultError(candidate)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func ErrorOf(errs ...error) Gen[error] {
+	return Elements(errs...)
+}
+
+func Result[T any](g Gen[T], errs Gen[error]) Gen[gofp.Result[T]] {
+	return Gen[gofp.Result[T]]{
+		Generate: func(r *rand.Rand, size int) gofp.Result[T] {
+			if r.IntN(4) == 0 {
+				return gofp.Err[T](errs.Generate(r, size))
+			}
+
+			return gofp.Ok(g.Generate(r, size))
+		},
+		Shrink: func(res gofp.Result[T]) iter.Seq[gofp.Result[T]] {
+			return func(yield func(gofp.Result[T]) bool) {
+				if res.IsErr() {
+					for err := range errs.Shrinks(res.UnwrapErr()) {
+						if !yield(gofp.Err[T](err)) {
+							return
+						}
+					}
+					return
+				}
+
+				for v := range g.Shrinks(res.Unwrap()) {
+					if !yield(gofp.Ok(v)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func Either[L, R any](genL Gen[L], genR Gen[R]) Gen[either.Either[L, R]] {
+	return Gen[either.Either[L, R]]{
+		Generate: func(r *rand.Rand, size int) either.Either[L, R] {
+			if r.IntN(2) == 0 {
+				return either.Left[L, R](genL.Generate(r, size))
+			}
+
+			return either.Right[L](genR.Generate(r, size))
+		},
+		Shrink: func(e either.Either[L, R]) iter.Seq[either.Either[L, R]] {
+			return func(yield func(either.Either[L, R]) bool) {
+				if e.IsLeft() {
+					for v := range genL.Shrinks(e.UnwrapLeft()) {
+						if !yield(either.Left[L, R](v)) {
+							return
+						}
+					}
+					return
+				}
+
+				for v := range genR.Shrinks(e.UnwrapRight()) {
+					if !yield(either.Right[L](v)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package prop
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Alsond5/gofp"
+)
+
+const SeedEnv = "GOFP_PROP_SEED"
+
+type Gen[T any] struct {
+	Generate func(r *rand.Rand, size int) T
+	Shrink   func(T) iter.Seq[T]
+}
+
+func (g Gen[T]) Shrinks(v T) iter.Seq[T] {
+	if g.Shrink == nil {
+		return func(func(T) bool) {}
+	}
+
+	return g.Shrink(v)
+}
+
+type Config struct {
+	Runs       int
+	Seed       uint64
+	MaxSize    int
+	MaxShrinks int
+}
+
+type Failure[T any] struct {
+	Seed     uint64
+	Run      int
+	Original T
+	Shrunk   T
+	Shrinks  int
+	Err      error
+}
+
+func (f Failure[T]) Error() string {
+	return fmt.Sprintf("property failed on run %d (%s=%d)\ncounterexample (%d shrinks): %#v\noriginal: %#v\nerror: %v",
+		f.Run, SeedEnv, f.Seed, f.Shrinks, f.Shrunk, f.Original, f.Err)
+}
+
+func (c Config) withDefaults() Config {
+	if c.Runs <= 0 {
+		c.Runs = 100
+	}
+	if c.MaxSize <= 0 {
+		c.MaxSize = 100
+	}
+	if c.MaxShrinks <= 0 {
+		c.MaxShrinks = 1000
+	}
+	if c.Seed == 0 {
+		c.Seed = seedFromEnv().UnwrapOrElse(func() uint64 {
+			return uint64(time.Now().UnixNano())
+		})
+	}
+
+	return c
+}
+
+func seedFromEnv() gofp.Option[uint64] {
+	raw, ok := os.LookupEnv(SeedEnv)
+	if !ok {
+		return gofp.None[uint64]()
+	}
+
+	return gofp.Of(strconv.ParseUint(raw, 10, 64)).Ok()
+}
+
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+}
+
+func Run[T any](cfg Config, g Gen[T], property func(T) error) gofp.Option[Failure[T]] {
+	cfg = cfg.withDefaults()
+	r := NewRand(cfg.Seed)
+
+	for run := range cfg.Runs {
+		size := 1 + run*cfg.MaxSize/cfg.Runs
+		v := g.Generate(r, size)
+
+		err := check(property, v)
+		if err == nil {
+			continue
+		}
+
+		shrunk, shrinks, err := shrink(cfg, g, property, v, err)
+		return gofp.Some(Failure[T]{
+			Seed:     cfg.Seed,
+			Run:      run,
+			Original: v,
+			Shrunk:   shrunk,
+			Shrinks:  shrinks,
+			Err:      err,
+		})
+	}
+
+	return gofp.None[Failure[T]]()
+}
+
+func ForAll[T any](t testing.TB, cfg Config, g Gen[T], property func(T) error) {
+	t.Helper()
+
+	Run(cfg, g, property).IfSome(func(f Failure[T]) {
+		t.Fatal(f.Error())
+	})
+}
+
+func check[T any](property func(T) error, v T) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+
+	return property(v)
+}
+
+func shrink[T any](cfg Config, g Gen[T], property func(T) error, v T, err error) (T, int, error) {
+	shrinks := 0
+	for shrinks < cfg.MaxShrinks {
+		progressed := false
+		for candidate := range g.Shrinks(v) {
+			if cerr := check(property, candidate); cerr != nil {
+				v, err = candidate, cerr
+				shrinks++
+				progressed = true
+				break
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	return v, shrinks, err
+}
@@ -0,0 +1,57 @@
+package prop
+
+import (
+	"iter"
+	"math/rand/v2"
+
+	"github.com/Alsond5/gofp/tuple"
+)
+
+func Pair[A, B any](genA Gen[A], genB Gen[B]) Gen[tuple.Pair[A, B]] {
+	return Gen[tuple.Pair[A, B]]{
+		Generate: func(r *rand.Rand, size int) tuple.Pair[A, B] {
+			return tuple.NewPair(genA.Generate(r, size), genB.Generate(r, size))
+		},
+		Shrink: func(p tuple.Pair[A, B]) iter.Seq[tuple.Pair[A, B]] {
+			return func(yield func(tuple.Pair[A, B]) bool) {
+				for a := range genA.Shrinks(p.First) {
+					if !yield(tuple.NewPair(a, p.Second)) {
+						return
+					}
+				}
+				for b := range genB.Shrinks(p.Second) {
+					if !yield(tuple.NewPair(p.First, b)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
+
+func Triple[A, B, C any](genA Gen[A], genB Gen[B], genC Gen[C]) Gen[tuple.Triple[A, B, C]] {
+	return Gen[tuple.Triple[A, B, C]]{
+		Generate: func(r *rand.Rand, size int) tuple.Triple[A, B, C] {
+			return tuple.NewTriple(genA.Generate(r, size), genB.Generate(r, size), genC.Generate(r, size))
+		},
+		Shrink: func(t tuple.Triple[A, B, C]) iter.Seq[tuple.Triple[A, B, C]] {
+			return func(yield func(tuple.Triple[A, B, C]) bool) {
+				for a := range genA.Shrinks(t.First) {
+					if !yield(tuple.NewTriple(a, t.Second, t.Third)) {
+						return
+					}
+				}
+				for b := range genB.Shrinks(t.Second) {
+					if !yield(tuple.NewTriple(t.First, b, t.Third)) {
+						return
+					}
+				}
+				for c := range genC.Shrinks(t.Third) {
+					if !yield(tuple.NewTriple(t.First, t.Second, c)) {
+						return
+					}
+				}
+			}
+		},
+	}
+}
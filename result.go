@@ -59,6 +59,54 @@ func Of3[A, B, C any](a A, b B, c C, err error) Result[tuple.Triple[A, B, C]] {
 	return Ok(tuple.Triple[A, B, C]{First: a, Second: b, Third: c})
 }
 
+func Of4[A, B, C, D any](a A, b B, c C, d D, err error) Result[tuple.Tuple4[A, B, C, D]] {
+	if err != nil {
+		return Err[tuple.Tuple4[A, B, C, D]](err)
+	}
+
+	return Ok(tuple.Tuple4[A, B, C, D]{First: a, Second: b, Third: c, Fourth: d})
+}
+
+func Of5[A, B, C, D, E any](a A, b B, c C, d D, e E, err error) Result[tuple.Tuple5[A, B, C, D, E]] {
+	if err != nil {
+		return Err[tuple.Tuple5[A, B, C, D, E]](err)
+	}
+
+	return Ok(tuple.Tuple5[A, B, C, D, E]{First: a, Second: b, Third: c, Fourth: d, Fifth: e})
+}
+
+func Of6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F, err error) Result[tuple.Tuple6[A, B, C, D, E, F]] {
+	if err != nil {
+		return Err[tuple.Tuple6[A, B, C, D, E, F]](err)
+	}
+
+	return Ok(tuple.Tuple6[A, B, C, D, E, F]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f})
+}
+
+func Of7[A, B, C, D, E, F, G any](a A, b B, c C, d D, e E, f F, g G, err error) Result[tuple.Tuple7[A, B, C, D, E, F, G]] {
+	if err != nil {
+		return Err[tuple.Tuple7[A, B, C, D, E, F, G]](err)
+	}
+
+	return Ok(tuple.Tuple7[A, B, C, D, E, F, G]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g})
+}
+
+func Of8[A, B, C, D, E, F, G, H any](a A, b B, c C, d D, e E, f F, g G, h H, err error) Result[tuple.Tuple8[A, B, C, D, E, F, G, H]] {
+	if err != nil {
+		return Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](err)
+	}
+
+	return Ok(tuple.Tuple8[A, B, C, D, E, F, G, H]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g, Eighth: h})
+}
+
+func Of9[A, B, C, D, E, F, G, H, I any](a A, b B, c C, d D, e E, f F, g G, h H, i I, err error) Result[tuple.Tuple9[A, B, C, D, E, F, G, H, I]] {
+	if err != nil {
+		return Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](err)
+	}
+
+	return Ok(tuple.Tuple9[A, B, C, D, E, F, G, H, I]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g, Eighth: h, Ninth: i})
+}
+
 func Try[T any](f func() T) (r Result[T]) {
 	defer func() {
 		rec := recover()
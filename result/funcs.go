@@ -72,6 +72,153 @@ func All3[A, B, C any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C]) gof
 	return gofp.Ok(tuple.Triple[A, B, C]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap()})
 }
 
+func All4[A, B, C, D any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C], d gofp.Result[D]) gofp.Result[tuple.Tuple4[A, B, C, D]] {
+	if a.IsErr() {
+		return gofp.Err[tuple.Tuple4[A, B, C, D]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return gofp.Err[tuple.Tuple4[A, B, C, D]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return gofp.Err[tuple.Tuple4[A, B, C, D]](c.UnwrapErr())
+	}
+	if d.IsErr() {
+		return gofp.Err[tuple.Tuple4[A, B, C, D]](d.UnwrapErr())
+	}
+
+	return gofp.Ok(tuple.Tuple4[A, B, C, D]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap()})
+}
+
+func All5[A, B, C, D, E any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C], d gofp.Result[D], e gofp.Result[E]) gofp.Result[tuple.Tuple5[A, B, C, D, E]] {
+	if a.IsErr() {
+		return gofp.Err[tuple.Tuple5[A, B, C, D, E]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return gofp.Err[tuple.Tuple5[A, B, C, D, E]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return gofp.Err[tuple.Tuple5[A, B, C, D, E]](c.UnwrapErr())
+	}
+	if d.IsErr() {
+		return gofp.Err[tuple.Tuple5[A, B, C, D, E]](d.UnwrapErr())
+	}
+	if e.IsErr() {
+		return gofp.Err[tuple.Tuple5[A, B, C, D, E]](e.UnwrapErr())
+	}
+
+	return gofp.Ok(tuple.Tuple5[A, B, C, D, E]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap()})
+}
+
+func All6[A, B, C, D, E, F any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C], d gofp.Result[D], e gofp.Result[E], f gofp.Result[F]) gofp.Result[tuple.Tuple6[A, B, C, D, E, F]] {
+	if a.IsErr() {
+		return gofp.Err[tuple.Tuple6[A, B, C, D, E, F]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return gofp.Err[tuple.Tuple6[A, B, C, D, E, F]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return gofp.Err[tuple.Tuple6[A, B, C, D, E, F]](c.UnwrapErr())
+	}
+	if d.IsErr() {
+		return gofp.Err[tuple.Tuple6[A, B, C, D, E, F]](d.UnwrapErr())
+	}
+	if e.IsErr() {
+		return gofp.Err[tuple.Tuple6[A, B, C, D, E, F]](e.UnwrapErr())
+	}
+	if f.IsErr() {
+		return gofp.Err[tuple.Tuple6[A, B, C, D, E, F]](f.UnwrapErr())
+	}
+
+	return gofp.Ok(tuple.Tuple6[A, B, C, D, E, F]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap()})
+}
+
+func All7[A, B, C, D, E, F, G any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C], d gofp.Result[D], e gofp.Result[E], f gofp.Result[F], g gofp.Result[G]) gofp.Result[tuple.Tuple7[A, B, C, D, E, F, G]] {
+	if a.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](c.UnwrapErr())
+	}
+	if d.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](d.UnwrapErr())
+	}
+	if e.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](e.UnwrapErr())
+	}
+	if f.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](f.UnwrapErr())
+	}
+	if g.IsErr() {
+		return gofp.Err[tuple.Tuple7[A, B, C, D, E, F, G]](g.UnwrapErr())
+	}
+
+	return gofp.Ok(tuple.Tuple7[A, B, C, D, E, F, G]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap(), Seventh: g.Unwrap()})
+}
+
+func All8[A, B, C, D, E, F, G, H any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C], d gofp.Result[D], e gofp.Result[E], f gofp.Result[F], g gofp.Result[G], h gofp.Result[H]) gofp.Result[tuple.Tuple8[A, B, C, D, E, F, G, H]] {
+	if a.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](c.UnwrapErr())
+	}
+	if d.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](d.UnwrapErr())
+	}
+	if e.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](e.UnwrapErr())
+	}
+	if f.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](f.UnwrapErr())
+	}
+	if g.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](g.UnwrapErr())
+	}
+	if h.IsErr() {
+		return gofp.Err[tuple.Tuple8[A, B, C, D, E, F, G, H]](h.UnwrapErr())
+	}
+
+	return gofp.Ok(tuple.Tuple8[A, B, C, D, E, F, G, H]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap(), Seventh: g.Unwrap(), Eighth: h.Unwrap()})
+}
+
+func All9[A, B, C, D, E, F, G, H, I any](a gofp.Result[A], b gofp.Result[B], c gofp.Result[C], d gofp.Result[D], e gofp.Result[E], f gofp.Result[F], g gofp.Result[G], h gofp.Result[H], i gofp.Result[I]) gofp.Result[tuple.Tuple9[A, B, C, D, E, F, G, H, I]] {
+	if a.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](c.UnwrapErr())
+	}
+	if d.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](d.UnwrapErr())
+	}
+	if e.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](e.UnwrapErr())
+	}
+	if f.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](f.UnwrapErr())
+	}
+	if g.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](g.UnwrapErr())
+	}
+	if h.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](h.UnwrapErr())
+	}
+	if i.IsErr() {
+		return gofp.Err[tuple.Tuple9[A, B, C, D, E, F, G, H, I]](i.UnwrapErr())
+	}
+
+	return gofp.Ok(tuple.Tuple9[A, B, C, D, E, F, G, H, I]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap(), Fourth: d.Unwrap(), Fifth: e.Unwrap(), Sixth: f.Unwrap(), Seventh: g.Unwrap(), Eighth: h.Unwrap(), Ninth: i.Unwrap()})
+}
+
 func AllOf[T any](results ...gofp.Result[T]) gofp.Result[[]T] {
 	values := make([]T, 0, len(results))
 	for _, r := range results {
@@ -0,0 +1,69 @@
+package result
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/Alsond5/gofp"
+)
+
+func Collect[T any](seq iter.Seq[gofp.Result[T]]) gofp.Result[[]T] {
+	values := make([]T, 0)
+	for r := range seq {
+		if r.IsErr() {
+			return gofp.Err[[]T](r.UnwrapErr())
+		}
+
+		values = append(values, r.Unwrap())
+	}
+
+	return gofp.Ok(values)
+}
+
+func CollectErrs[T any](seq iter.Seq[gofp.Result[T]]) gofp.Result[[]T] {
+	values := make([]T, 0)
+
+	var errs []error
+	for r := range seq {
+		if r.IsErr() {
+			errs = append(errs, r.UnwrapErr())
+		} else {
+			values = append(values, r.Unwrap())
+		}
+	}
+	if len(errs) > 0 {
+		return gofp.Err[[]T](errors.Join(errs...))
+	}
+
+	return gofp.Ok(values)
+}
+
+func Oks[T any](seq iter.Seq[gofp.Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for r := range seq {
+			if r.IsOk() && !yield(r.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+func Errs[T any](seq iter.Seq[gofp.Result[T]]) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		for r := range seq {
+			if r.IsErr() && !yield(r.UnwrapErr()) {
+				return
+			}
+		}
+	}
+}
+
+func MapSeq[T, U any](seq iter.Seq[T], f func(T) gofp.Result[U]) iter.Seq[gofp.Result[U]] {
+	return func(yield func(gofp.Result[U]) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Alsond5/gofp"
+)
+
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexedError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexedError) Unwrap() error {
+	return e.Err
+}
+
+func ParMapAllOk[T, U any](ctx context.Context, slice []T, workers int, f func(context.Context, T) gofp.Result[U]) gofp.Result[[]U] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	out := make([]U, len(slice))
+
+	var once sync.Once
+	var firstErr error
+	sent := parRun(ctx, len(slice), workers, func(i int) {
+		r := f(ctx, slice[i])
+		if r.IsErr() {
+			once.Do(func() {
+				firstErr = r.UnwrapErr()
+				cancel()
+			})
+			return
+		}
+
+		out[i] = r.Unwrap()
+	})
+	if firstErr != nil {
+		return gofp.Err[[]U](firstErr)
+	}
+	if sent < len(slice) {
+		return gofp.Err[[]U](ctx.Err())
+	}
+
+	return gofp.Ok(out)
+}
+
+func ParMapAllOkCollectErrs[T, U any](ctx context.Context, slice []T, workers int, f func(context.Context, T) gofp.Result[U]) gofp.Result[[]U] {
+	out := make([]U, len(slice))
+	errs := make([]error, len(slice))
+	sent := parRun(ctx, len(slice), workers, func(i int) {
+		r := f(ctx, slice[i])
+		if r.IsErr() {
+			errs[i] = &IndexedError{Index: i, Err: r.UnwrapErr()}
+			return
+		}
+
+		out[i] = r.Unwrap()
+	})
+	if sent < len(slice) {
+		errs = append(errs, ctx.Err())
+	}
+	if err := errors.Join(errs...); err != nil {
+		return gofp.Err[[]U](err)
+	}
+
+	return gofp.Ok(out)
+}
+
+func parRun(ctx context.Context, n, workers int, fn func(int)) (sent int) {
+	if workers <= 0 || workers > n {
+		workers = n
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		panicVal any
+		panicked bool
+	)
+
+	next := make(chan int)
+	for range workers {
+		wg.Go(func() {
+			for i := range next {
+				func() {
+					defer func() {
+						if rec := recover(); rec != nil {
+							once.Do(func() {
+								panicked, panicVal = true, rec
+								cancel()
+							})
+						}
+					}()
+
+					fn(i)
+				}()
+			}
+		})
+	}
+
+feed:
+	for sent < n {
+		select {
+		case next <- sent:
+			sent++
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	if panicked {
+		panic(panicVal)
+	}
+
+	return sent
+}
@@ -0,0 +1,87 @@
+package result
+
+import (
+	"iter"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/tuple"
+)
+
+func FromSeq2[T any](seq iter.Seq2[T, error]) iter.Seq[gofp.Result[T]] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for v, err := range seq {
+			if !yield(gofp.Of(v, err)) {
+				return
+			}
+		}
+	}
+}
+
+func FromSeq2UntilErr[T any](seq iter.Seq2[T, error]) iter.Seq[gofp.Result[T]] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for v, err := range seq {
+			if !yield(gofp.Of(v, err)) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+func ToSeq2[T any](seq iter.Seq[gofp.Result[T]]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range seq {
+			if !yield(r.Unpack()) {
+				return
+			}
+		}
+	}
+}
+
+func ToSeq2UntilErr[T any](seq iter.Seq[gofp.Result[T]]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range seq {
+			if !yield(r.Unpack()) || r.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+func OfSeq[T any](seq iter.Seq[T], errFn func() error) iter.Seq[gofp.Result[T]] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for v := range seq {
+			if !yield(gofp.Ok(v)) {
+				return
+			}
+		}
+		if err := errFn(); err != nil {
+			yield(gofp.Err[T](err))
+		}
+	}
+}
+
+func Of2Seq[A, B any](seq iter.Seq2[A, B], errFn func() error) iter.Seq[gofp.Result[tuple.Pair[A, B]]] {
+	return func(yield func(gofp.Result[tuple.Pair[A, B]]) bool) {
+		for a, b := range seq {
+			if !yield(gofp.Ok(tuple.Pair[A, B]{First: a, Second: b})) {
+				return
+			}
+		}
+		if err := errFn(); err != nil {
+			yield(gofp.Err[tuple.Pair[A, B]](err))
+		}
+	}
+}
+
+func Of3Seq[A, B, C any](seq iter.Seq2[tuple.Pair[A, B], C], errFn func() error) iter.Seq[gofp.Result[tuple.Triple[A, B, C]]] {
+	return func(yield func(gofp.Result[tuple.Triple[A, B, C]]) bool) {
+		for p, c := range seq {
+			if !yield(gofp.Ok(tuple.Triple[A, B, C]{First: p.First, Second: p.Second, Third: c})) {
+				return
+			}
+		}
+		if err := errFn(); err != nil {
+			yield(gofp.Err[tuple.Triple[A, B, C]](err))
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package resulte
+
+import (
+	"github.com/Alsond5/gofp/tuple"
+)
+
+func Map[T, U, E any](r Result[T, E], f func(T) U) Result[U, E] {
+	if r.IsErr() {
+		return Err[U](r.UnwrapErr())
+	}
+
+	return Ok[U, E](f(r.Unwrap()))
+}
+
+func MapErr[T, E, F any](r Result[T, E], f func(E) F) Result[T, F] {
+	if r.IsErr() {
+		return Err[T](f(r.UnwrapErr()))
+	}
+
+	return Ok[T, F](r.Unwrap())
+}
+
+func FlatMap[T, U, E any](r Result[T, E], f func(T) Result[U, E]) Result[U, E] {
+	if r.IsErr() {
+		return Err[U](r.UnwrapErr())
+	}
+
+	return f(r.Unwrap())
+}
+
+func And[T, U, E any](r Result[T, E], other Result[U, E]) Result[U, E] {
+	if r.IsErr() {
+		return Err[U](r.UnwrapErr())
+	}
+
+	return other
+}
+
+func AndThen[T, U, E any](r Result[T, E], f func(T) Result[U, E]) Result[U, E] {
+	if r.IsErr() {
+		return Err[U](r.UnwrapErr())
+	}
+
+	return f(r.Unwrap())
+}
+
+func Flatten[T, E any](r Result[Result[T, E], E]) Result[T, E] {
+	if r.IsErr() {
+		return Err[T](r.UnwrapErr())
+	}
+
+	return r.Unwrap()
+}
+
+func All2[A, B, E any](a Result[A, E], b Result[B, E]) Result[tuple.Pair[A, B], E] {
+	if a.IsErr() {
+		return Err[tuple.Pair[A, B]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return Err[tuple.Pair[A, B]](b.UnwrapErr())
+	}
+
+	return Ok[tuple.Pair[A, B], E](tuple.Pair[A, B]{First: a.Unwrap(), Second: b.Unwrap()})
+}
+
+func All3[A, B, C, E any](a Result[A, E], b Result[B, E], c Result[C, E]) Result[tuple.Triple[A, B, C], E] {
+	if a.IsErr() {
+		return Err[tuple.Triple[A, B, C]](a.UnwrapErr())
+	}
+	if b.IsErr() {
+		return Err[tuple.Triple[A, B, C]](b.UnwrapErr())
+	}
+	if c.IsErr() {
+		return Err[tuple.Triple[A, B, C]](c.UnwrapErr())
+	}
+
+	return Ok[tuple.Triple[A, B, C], E](tuple.Triple[A, B, C]{First: a.Unwrap(), Second: b.Unwrap(), Third: c.Unwrap()})
+}
+
+func AllOf[T, E any](results ...Result[T, E]) Result[[]T, E] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if r.IsErr() {
+			return Err[[]T](r.UnwrapErr())
+		}
+
+		values = append(values, r.Unwrap())
+	}
+
+	return Ok[[]T, E](values)
+}
+
+func AllOfCollectErrs[T, E any](results ...Result[T, E]) Result[[]T, []E] {
+	values := make([]T, 0, len(results))
+
+	var errs []E
+	for _, r := range results {
+		if r.IsErr() {
+			errs = append(errs, r.UnwrapErr())
+		} else {
+			values = append(values, r.Unwrap())
+		}
+	}
+	if len(errs) > 0 {
+		return Err[[]T](errs)
+	}
+
+	return Ok[[]T, []E](values)
+}
+
+func FirstOk[T, E any](results ...Result[T, E]) Result[T, []E] {
+	errs := make([]E, 0, len(results))
+	for _, r := range results {
+		if r.IsOk() {
+			return Ok[T, []E](r.Unwrap())
+		}
+
+		errs = append(errs, r.UnwrapErr())
+	}
+
+	return Err[T](errs)
+}
+
+func AllErrors[T, E any](results ...Result[T, E]) []E {
+	var errs []E
+	for _, r := range results {
+		if r.IsErr() {
+			errs = append(errs, r.UnwrapErr())
+		}
+	}
+
+	return errs
+}
+
+func Partition[T, E any](results ...Result[T, E]) (values []T, errs []E) {
+	for _, r := range results {
+		if r.IsOk() {
+			values = append(values, r.Unwrap())
+		} else {
+			errs = append(errs, r.UnwrapErr())
+		}
+	}
+
+	return values, errs
+}
+
+func PartitionResults[T, E any](results ...Result[T, E]) (oks []Result[T, E], errs []Result[T, E]) {
+	for _, r := range results {
+		if r.IsOk() {
+			oks = append(oks, r)
+		} else {
+			errs = append(errs, r)
+		}
+	}
+
+	return oks, errs
+}
+
+func MapAll[T, U, E any](slice []T, f func(T) Result[U, E]) []Result[U, E] {
+	out := make([]Result[U, E], len(slice))
+	for i, v := range slice {
+		out[i] = f(v)
+	}
+
+	return out
+}
+
+func MapAllOk[T, U, E any](slice []T, f func(T) Result[U, E]) Result[[]U, E] {
+	return AllOf(MapAll(slice, f)...)
+}
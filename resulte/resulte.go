@@ -0,0 +1,266 @@
+package resulte
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Alsond5/gofp"
+)
+
+type Result[T, E any] struct {
+	value T
+	err   E
+	ok    bool
+}
+
+type ErrorValue[E any] struct {
+	Value E
+}
+
+func (e ErrorValue[E]) Error() string {
+	return fmt.Sprint(e.Value)
+}
+
+func Ok[T, E any](value T) Result[T, E] {
+	return Result[T, E]{value: value, ok: true}
+}
+
+func Err[T, E any](err E) Result[T, E] {
+	return Result[T, E]{err: err, ok: false}
+}
+
+func Of[T any, E interface {
+	comparable
+	error
+}](value T, err E) Result[T, E] {
+	var zero E
+	if err != zero {
+		return Err[T](err)
+	}
+
+	return Ok[T, E](value)
+}
+
+func FromResult[T any, E error](r gofp.Result[T]) gofp.Option[Result[T, E]] {
+	if r.IsOk() {
+		return gofp.Some(Ok[T, E](r.Unwrap()))
+	}
+
+	var target E
+	if errors.As(r.UnwrapErr(), &target) {
+		return gofp.Some(Err[T](target))
+	}
+
+	return gofp.None[Result[T, E]]()
+}
+
+func FromResultWith[T, E any](r gofp.Result[T], convert func(error) E) Result[T, E] {
+	if r.IsOk() {
+		return Ok[T, E](r.Unwrap())
+	}
+
+	return Err[T](convert(r.UnwrapErr()))
+}
+
+func ToResult[T any, E error](r Result[T, E]) gofp.Result[T] {
+	if r.ok {
+		return gofp.Ok(r.value)
+	}
+
+	return gofp.Err[T](r.err)
+}
+
+func ToResultWith[T, E any](r Result[T, E], convert func(E) error) gofp.Result[T] {
+	if r.ok {
+		return gofp.Ok(r.value)
+	}
+
+	return gofp.Err[T](convert(r.err))
+}
+
+func asError[E any](err E) error {
+	if e, ok := any(err).(error); ok {
+		return e
+	}
+
+	return ErrorValue[E]{Value: err}
+}
+
+func raise(err error) {
+	gofp.Err[gofp.Unit](err).Unwrap()
+}
+
+func (r Result[T, E]) Unwrap() T {
+	if !r.ok {
+		raise(asError(r.err))
+	}
+
+	return r.value
+}
+
+func (r Result[T, E]) UnwrapErr() E {
+	if r.ok {
+		panic(r.value)
+	}
+
+	return r.err
+}
+
+func (r Result[T, E]) UnwrapOr(defaultValue T) T {
+	if !r.ok {
+		return defaultValue
+	}
+
+	return r.value
+}
+
+func (r Result[T, E]) UnwrapOrElse(f func(E) T) T {
+	if !r.ok {
+		return f(r.err)
+	}
+
+	return r.value
+}
+
+func (r Result[T, E]) UnwrapOrZero() T {
+	if !r.ok {
+		var zero T
+		return zero
+	}
+
+	return r.value
+}
+
+func (r Result[T, E]) Expect(msg string) T {
+	if !r.ok {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+
+	return r.value
+}
+
+func (r Result[T, E]) ExpectErr(msg string) E {
+	if r.ok {
+		panic(fmt.Sprintf("%s: %v", msg, r.value))
+	}
+
+	return r.err
+}
+
+func (r Result[T, E]) IntoErr() E {
+	return r.err
+}
+
+func (r Result[T, E]) IntoOk() T {
+	return r.value
+}
+
+func (r Result[T, E]) Ok() gofp.Option[T] {
+	if r.ok {
+		return gofp.Some(r.value)
+	}
+
+	return gofp.None[T]()
+}
+
+func (r Result[T, E]) Err() gofp.Option[E] {
+	if !r.ok {
+		return gofp.Some(r.err)
+	}
+
+	return gofp.None[E]()
+}
+
+func (r Result[T, E]) Unpack() (T, E) {
+	return r.value, r.err
+}
+
+func (r Result[T, E]) IsOk() bool {
+	return r.ok
+}
+
+func (r Result[T, E]) IsOkAnd(f func(T) bool) bool {
+	return r.ok && f(r.value)
+}
+
+func (r Result[T, E]) IsErr() bool {
+	return !r.ok
+}
+
+func (r Result[T, E]) IsErrAnd(f func(E) bool) bool {
+	return !r.ok && f(r.err)
+}
+
+func (r Result[T, E]) MapErr(f func(E) E) Result[T, E] {
+	if !r.ok {
+		return Err[T](f(r.err))
+	}
+
+	return r
+}
+
+func (r Result[T, E]) OrElse(f func(E) Result[T, E]) Result[T, E] {
+	if !r.ok {
+		return f(r.err)
+	}
+
+	return r
+}
+
+func (r Result[T, E]) Or(alternative Result[T, E]) Result[T, E] {
+	if !r.ok {
+		return alternative
+	}
+
+	return r
+}
+
+func (r Result[T, E]) ContainsErr(target E) bool {
+	if r.ok {
+		return false
+	}
+
+	if err, ok := any(r.err).(error); ok {
+		if t, ok := any(target).(error); ok {
+			return errors.Is(err, t)
+		}
+	}
+
+	a, b := any(r.err), any(target)
+	if a != nil && !reflect.ValueOf(a).Comparable() {
+		return false
+	}
+
+	return a == b
+}
+
+func (r Result[T, E]) IfOk(f func(T)) Result[T, E] {
+	if r.ok {
+		f(r.value)
+	}
+
+	return r
+}
+
+func (r Result[T, E]) IfErr(f func(E)) Result[T, E] {
+	if !r.ok {
+		f(r.err)
+	}
+
+	return r
+}
+
+func (r Result[T, E]) Tap(okFn func(T), errFn func(E)) Result[T, E] {
+	if r.ok {
+		if okFn != nil {
+			okFn(r.value)
+		}
+	} else {
+		if errFn != nil {
+			errFn(r.err)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,52 @@
+package retry
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+type Backoff func(attempt int, prev time.Duration) time.Duration
+
+func Constant(d time.Duration) Backoff {
+	return func(int, time.Duration) time.Duration {
+		return d
+	}
+}
+
+func Exponential(base, max time.Duration) Backoff {
+	return func(attempt int, _ time.Duration) time.Duration {
+		d := base
+		for i := 1; i < attempt; i++ {
+			if d >= max/2 {
+				return max
+			}
+
+			d *= 2
+		}
+
+		return min(d, max)
+	}
+}
+
+func DecorrelatedJitter(base, max time.Duration, rng *rand.Rand) Backoff {
+	int64N := rand.Int64N
+	if rng != nil {
+		int64N = rng.Int64N
+	}
+
+	return func(_ int, prev time.Duration) time.Duration {
+		if prev < base {
+			prev = base
+		}
+
+		upper := max
+		if prev <= max/3 {
+			upper = prev * 3
+		}
+		if upper <= base {
+			return min(base, max)
+		}
+
+		return base + time.Duration(int64N(int64(upper-base)))
+	}
+}
@@ -0,0 +1,110 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/clock"
+)
+
+const (
+	DefaultMaxAttempts = 3
+	DefaultBaseDelay   = 100 * time.Millisecond
+	DefaultMaxDelay    = 5 * time.Second
+)
+
+type Policy struct {
+	Backoff     Backoff
+	MaxAttempts int
+	MaxElapsed  time.Duration
+	Retryable   func(error) bool
+	Clock       clock.Clock
+	OnRetry     func(attempt int, err error, delay time.Duration)
+}
+
+type AttemptError struct {
+	Attempt int
+	Err     error
+}
+
+func (e *AttemptError) Error() string {
+	return fmt.Sprintf("attempt %d: %v", e.Attempt, e.Err)
+}
+
+func (e *AttemptError) Unwrap() error {
+	return e.Err
+}
+
+func On(targets ...error) func(error) bool {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func Except(targets ...error) func(error) bool {
+	on := On(targets...)
+	return func(err error) bool {
+		return !on(err)
+	}
+}
+
+func (p Policy) retryable(err error) bool {
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+func Do[T any](ctx context.Context, p Policy, f func(context.Context) gofp.Result[T]) gofp.Result[T] {
+	if p.MaxAttempts <= 0 && p.MaxElapsed <= 0 {
+		p.MaxAttempts = DefaultMaxAttempts
+	}
+	if p.Backoff == nil {
+		p.Backoff = Exponential(DefaultBaseDelay, DefaultMaxDelay)
+	}
+
+	clk := p.Clock
+	if clk == nil {
+		clk = clock.System()
+	}
+
+	start := clk.Now()
+
+	var errs []error
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		r := f(ctx)
+		if r.IsOk() {
+			return r
+		}
+
+		errs = append(errs, &AttemptError{Attempt: attempt, Err: r.UnwrapErr()})
+		if !r.IsErrAnd(p.retryable) || ctx.Err() != nil {
+			break
+		}
+		if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+			break
+		}
+
+		delay = p.Backoff(attempt, delay)
+		if p.MaxElapsed > 0 && clk.Now().Add(delay).Sub(start) > p.MaxElapsed {
+			break
+		}
+
+		if p.OnRetry != nil {
+			p.OnRetry(attempt, r.UnwrapErr(), delay)
+		}
+		if err := clk.Sleep(ctx, delay); err != nil {
+			errs = append(errs, err)
+			break
+		}
+	}
+
+	return gofp.Err[T](errors.Join(errs...))
+}
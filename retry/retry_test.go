@@ -0,0 +1,38 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/clock"
+)
+
+func TestDoMaxElapsedWithoutBackoff(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+	errUnavailable := errors.New("unavailable")
+
+	calls := 0
+	r := Do(context.Background(), Policy{MaxElapsed: 10 * time.Second, Clock: clk}, func(context.Context) gofp.Result[int] {
+		calls++
+		return gofp.Err[int](errUnavailable)
+	})
+
+	if !r.ContainsErr(errUnavailable) {
+		t.Fatalf("Do() = %v, want Err containing %v", r, errUnavailable)
+	}
+	if elapsed := clk.Now().Sub(start); elapsed > 10*time.Second {
+		t.Errorf("elapsed %v, want at most 10s", elapsed)
+	}
+	if want := len(clk.Sleeps()) + 1; calls != want {
+		t.Errorf("calls = %d, want %d", calls, want)
+	}
+	for i, d := range clk.Sleeps() {
+		if d <= 0 {
+			t.Errorf("sleep %d = %v, want a positive delay", i, d)
+		}
+	}
+}
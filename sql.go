@@ -0,0 +1,32 @@
+package gofp
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+func FromNull[T any](n sql.Null[T]) Option[T] {
+	if !n.Valid {
+		return None[T]()
+	}
+
+	return Some(n.V)
+}
+
+func (o Option[T]) ToNull() sql.Null[T] {
+	return sql.Null[T]{V: o.value, Valid: o.ok}
+}
+
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+
+	*o = FromNull(n)
+	return nil
+}
+
+func (o Option[T]) Value() (driver.Value, error) {
+	return o.ToNull().Value()
+}
@@ -0,0 +1,39 @@
+package io
+
+import (
+	"io"
+
+	"github.com/Alsond5/gofp"
+)
+
+func ReadAll(r io.Reader) gofp.Result[[]byte] {
+	return gofp.Of(io.ReadAll(r))
+}
+
+func ReadFull(r io.Reader, buf []byte) gofp.Result[int] {
+	return gofp.Of(io.ReadFull(r, buf))
+}
+
+func ReadAtLeast(r io.Reader, buf []byte, min int) gofp.Result[int] {
+	return gofp.Of(io.ReadAtLeast(r, buf, min))
+}
+
+func Copy(dst io.Writer, src io.Reader) gofp.Result[int64] {
+	return gofp.Of(io.Copy(dst, src))
+}
+
+func CopyN(dst io.Writer, src io.Reader, n int64) gofp.Result[int64] {
+	return gofp.Of(io.CopyN(dst, src, n))
+}
+
+func CopyBuffer(dst io.Writer, src io.Reader, buf []byte) gofp.Result[int64] {
+	return gofp.Of(io.CopyBuffer(dst, src, buf))
+}
+
+func WriteString(w io.Writer, s string) gofp.Result[int] {
+	return gofp.Of(io.WriteString(w, s))
+}
+
+func Close(c io.Closer) gofp.Result[gofp.Unit] {
+	return gofp.Do(c.Close())
+}
@@ -0,0 +1,61 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/Alsond5/gofp"
+)
+
+func Marshal(v any) gofp.Result[[]byte] {
+	return gofp.Of(json.Marshal(v))
+}
+
+func MarshalIndent(v any, prefix, indent string) gofp.Result[[]byte] {
+	return gofp.Of(json.MarshalIndent(v, prefix, indent))
+}
+
+func Unmarshal[T any](data []byte) gofp.Result[T] {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return gofp.Err[T](err)
+	}
+
+	return gofp.Ok(v)
+}
+
+func UnmarshalInto(data []byte, v any) gofp.Result[gofp.Unit] {
+	return gofp.Do(json.Unmarshal(data, v))
+}
+
+func Decode[T any](r io.Reader) gofp.Result[T] {
+	var v T
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return gofp.Err[T](err)
+	}
+
+	return gofp.Ok(v)
+}
+
+func Encode(w io.Writer, v any) gofp.Result[gofp.Unit] {
+	return gofp.Do(json.NewEncoder(w).Encode(v))
+}
+
+func Compact(src []byte) gofp.Result[[]byte] {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, src); err != nil {
+		return gofp.Err[[]byte](err)
+	}
+
+	return gofp.Ok(buf.Bytes())
+}
+
+func Indent(src []byte, prefix, indent string) gofp.Result[[]byte] {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, src, prefix, indent); err != nil {
+		return gofp.Err[[]byte](err)
+	}
+
+	return gofp.Ok(buf.Bytes())
+}
@@ -0,0 +1,16 @@
+package maps
+
+import "github.com/Alsond5/gofp"
+
+func Get[M ~map[K]V, K comparable, V any](m M, key K) gofp.Option[V] {
+	v, ok := m[key]
+	if !ok {
+		return gofp.None[V]()
+	}
+
+	return gofp.Some(v)
+}
+
+func GetOrErr[M ~map[K]V, K comparable, V any](m M, key K, err error) gofp.Result[V] {
+	return Get(m, key).OkOr(err)
+}
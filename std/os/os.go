@@ -0,0 +1,137 @@
+package os
+
+import (
+	"io/fs"
+	"os"
+
+	"github.com/Alsond5/gofp"
+)
+
+func LookupEnv(key string) gofp.Option[string] {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return gofp.None[string]()
+	}
+
+	return gofp.Some(value)
+}
+
+func Setenv(key, value string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Setenv(key, value))
+}
+
+func Unsetenv(key string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Unsetenv(key))
+}
+
+func ReadFile(name string) gofp.Result[[]byte] {
+	return gofp.Of(os.ReadFile(name))
+}
+
+func WriteFile(name string, data []byte, perm fs.FileMode) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.WriteFile(name, data, perm))
+}
+
+func Open(name string) gofp.Result[*os.File] {
+	return gofp.Of(os.Open(name))
+}
+
+func Create(name string) gofp.Result[*os.File] {
+	return gofp.Of(os.Create(name))
+}
+
+func OpenFile(name string, flag int, perm fs.FileMode) gofp.Result[*os.File] {
+	return gofp.Of(os.OpenFile(name, flag, perm))
+}
+
+func CreateTemp(dir, pattern string) gofp.Result[*os.File] {
+	return gofp.Of(os.CreateTemp(dir, pattern))
+}
+
+func MkdirTemp(dir, pattern string) gofp.Result[string] {
+	return gofp.Of(os.MkdirTemp(dir, pattern))
+}
+
+func Stat(name string) gofp.Result[fs.FileInfo] {
+	return gofp.Of(os.Stat(name))
+}
+
+func Lstat(name string) gofp.Result[fs.FileInfo] {
+	return gofp.Of(os.Lstat(name))
+}
+
+func StatIfExists(name string) gofp.Result[gofp.Option[fs.FileInfo]] {
+	info, err := os.Stat(name)
+	if os.IsNotExist(err) {
+		return gofp.Ok(gofp.None[fs.FileInfo]())
+	}
+	if err != nil {
+		return gofp.Err[gofp.Option[fs.FileInfo]](err)
+	}
+
+	return gofp.Ok(gofp.Some(info))
+}
+
+func ReadDir(name string) gofp.Result[[]os.DirEntry] {
+	return gofp.Of(os.ReadDir(name))
+}
+
+func Mkdir(name string, perm fs.FileMode) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Mkdir(name, perm))
+}
+
+func MkdirAll(path string, perm fs.FileMode) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.MkdirAll(path, perm))
+}
+
+func Remove(name string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Remove(name))
+}
+
+func RemoveAll(path string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.RemoveAll(path))
+}
+
+func Rename(oldpath, newpath string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Rename(oldpath, newpath))
+}
+
+func Chmod(name string, mode fs.FileMode) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Chmod(name, mode))
+}
+
+func Chdir(dir string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Chdir(dir))
+}
+
+func Symlink(oldname, newname string) gofp.Result[gofp.Unit] {
+	return gofp.Do(os.Symlink(oldname, newname))
+}
+
+func Readlink(name string) gofp.Result[string] {
+	return gofp.Of(os.Readlink(name))
+}
+
+func Getwd() gofp.Result[string] {
+	return gofp.Of(os.Getwd())
+}
+
+func Hostname() gofp.Result[string] {
+	return gofp.Of(os.Hostname())
+}
+
+func Executable() gofp.Result[string] {
+	return gofp.Of(os.Executable())
+}
+
+func UserHomeDir() gofp.Result[string] {
+	return gofp.Of(os.UserHomeDir())
+}
+
+func UserConfigDir() gofp.Result[string] {
+	return gofp.Of(os.UserConfigDir())
+}
+
+func UserCacheDir() gofp.Result[string] {
+	return gofp.Of(os.UserCacheDir())
+}
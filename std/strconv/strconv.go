@@ -0,0 +1,44 @@
+package strconv
+
+import (
+	"strconv"
+
+	"github.com/Alsond5/gofp"
+	"github.com/Alsond5/gofp/tuple"
+)
+
+func Atoi(s string) gofp.Result[int] {
+	return gofp.Of(strconv.Atoi(s))
+}
+
+func ParseBool(str string) gofp.Result[bool] {
+	return gofp.Of(strconv.ParseBool(str))
+}
+
+func ParseInt(s string, base int, bitSize int) gofp.Result[int64] {
+	return gofp.Of(strconv.ParseInt(s, base, bitSize))
+}
+
+func ParseUint(s string, base int, bitSize int) gofp.Result[uint64] {
+	return gofp.Of(strconv.ParseUint(s, base, bitSize))
+}
+
+func ParseFloat(s string, bitSize int) gofp.Result[float64] {
+	return gofp.Of(strconv.ParseFloat(s, bitSize))
+}
+
+func ParseComplex(s string, bitSize int) gofp.Result[complex128] {
+	return gofp.Of(strconv.ParseComplex(s, bitSize))
+}
+
+func Unquote(s string) gofp.Result[string] {
+	return gofp.Of(strconv.Unquote(s))
+}
+
+func UnquoteChar(s string, quote byte) gofp.Result[tuple.Triple[rune, bool, string]] {
+	return gofp.Of3(strconv.UnquoteChar(s, quote))
+}
+
+func QuotedPrefix(s string) gofp.Result[string] {
+	return gofp.Of(strconv.QuotedPrefix(s))
+}
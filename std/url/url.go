@@ -0,0 +1,49 @@
+package url
+
+import (
+	"net/url"
+
+	"github.com/Alsond5/gofp"
+)
+
+func Parse(rawURL string) gofp.Result[*url.URL] {
+	return gofp.Of(url.Parse(rawURL))
+}
+
+func ParseRequestURI(rawURL string) gofp.Result[*url.URL] {
+	return gofp.Of(url.ParseRequestURI(rawURL))
+}
+
+func ParseQuery(query string) gofp.Result[url.Values] {
+	return gofp.Of(url.ParseQuery(query))
+}
+
+func QueryUnescape(s string) gofp.Result[string] {
+	return gofp.Of(url.QueryUnescape(s))
+}
+
+func PathUnescape(s string) gofp.Result[string] {
+	return gofp.Of(url.PathUnescape(s))
+}
+
+func JoinPath(base string, elem ...string) gofp.Result[string] {
+	return gofp.Of(url.JoinPath(base, elem...))
+}
+
+func Lookup(values url.Values, key string) gofp.Option[string] {
+	vs, ok := values[key]
+	if !ok || len(vs) == 0 {
+		return gofp.None[string]()
+	}
+
+	return gofp.Some(vs[0])
+}
+
+func LookupAll(values url.Values, key string) gofp.Option[[]string] {
+	vs, ok := values[key]
+	if !ok || len(vs) == 0 {
+		return gofp.None[[]string]()
+	}
+
+	return gofp.Some(vs)
+}
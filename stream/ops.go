@@ -0,0 +1,205 @@
+package stream
+
+import (
+	"github.com/Alsond5/gofp"
+)
+
+func Map[T, U any](s Stream[T], f func(T) U) Stream[U] {
+	return func(yield func(gofp.Result[U]) bool) {
+		for r := range s {
+			if r.IsErr() {
+				yield(gofp.Err[U](r.UnwrapErr()))
+				return
+			}
+			if !yield(gofp.Ok(f(r.Unwrap()))) {
+				return
+			}
+		}
+	}
+}
+
+func TryMap[T, U any](s Stream[T], f func(T) gofp.Result[U]) Stream[U] {
+	return func(yield func(gofp.Result[U]) bool) {
+		for r := range s {
+			if r.IsErr() {
+				yield(gofp.Err[U](r.UnwrapErr()))
+				return
+			}
+
+			out := f(r.Unwrap())
+			if !yield(out) || out.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+func FlatMap[T, U any](s Stream[T], f func(T) Stream[U]) Stream[U] {
+	return func(yield func(gofp.Result[U]) bool) {
+		for r := range s {
+			if r.IsErr() {
+				yield(gofp.Err[U](r.UnwrapErr()))
+				return
+			}
+
+			for inner := range f(r.Unwrap()) {
+				if !yield(inner) || inner.IsErr() {
+					return
+				}
+			}
+		}
+	}
+}
+
+func Filter[T any](s Stream[T], pred func(T) bool) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for r := range s {
+			if r.IsErr() {
+				yield(r)
+				return
+			}
+			if pred(r.Unwrap()) && !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+func Take[T any](s Stream[T], n int) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		if n <= 0 {
+			return
+		}
+
+		taken := 0
+		for r := range s {
+			if !yield(r) || r.IsErr() {
+				return
+			}
+
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
+
+func TakeWhile[T any](s Stream[T], pred func(T) bool) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for r := range s {
+			if r.IsErr() {
+				yield(r)
+				return
+			}
+			if !pred(r.Unwrap()) || !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+func Skip[T any](s Stream[T], n int) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		skipped := 0
+		for r := range s {
+			if r.IsErr() {
+				yield(r)
+				return
+			}
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(r) {
+				return
+			}
+		}
+	}
+}
+
+func Chunk[T any](s Stream[T], n int) Stream[[]T] {
+	if n < 1 {
+		panic("stream.Chunk: n cannot be less than 1")
+	}
+
+	return func(yield func(gofp.Result[[]T]) bool) {
+		chunk := make([]T, 0, n)
+		for r := range s {
+			if r.IsErr() {
+				yield(gofp.Err[[]T](r.UnwrapErr()))
+				return
+			}
+
+			chunk = append(chunk, r.Unwrap())
+			if len(chunk) == n {
+				if !yield(gofp.Ok(chunk)) {
+					return
+				}
+
+				chunk = make([]T, 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(gofp.Ok(chunk))
+		}
+	}
+}
+
+func Window[T any](s Stream[T], n int) Stream[[]T] {
+	if n < 1 {
+		panic("stream.Window: n cannot be less than 1")
+	}
+
+	return func(yield func(gofp.Result[[]T]) bool) {
+		ring := make([]T, n)
+		seen := 0
+		for r := range s {
+			if r.IsErr() {
+				yield(gofp.Err[[]T](r.UnwrapErr()))
+				return
+			}
+
+			ring[seen%n] = r.Unwrap()
+			seen++
+			if seen < n {
+				continue
+			}
+
+			window := make([]T, n)
+			start := seen % n
+			copy(window, ring[start:])
+			copy(window[n-start:], ring[:start])
+			if !yield(gofp.Ok(window)) {
+				return
+			}
+		}
+	}
+}
+
+func Scan[T, U any](s Stream[T], initial U, f func(U, T) U) Stream[U] {
+	return func(yield func(gofp.Result[U]) bool) {
+		acc := initial
+		for r := range s {
+			if r.IsErr() {
+				yield(gofp.Err[U](r.UnwrapErr()))
+				return
+			}
+
+			acc = f(acc, r.Unwrap())
+			if !yield(gofp.Ok(acc)) {
+				return
+			}
+		}
+	}
+}
+
+func Inspect[T any](s Stream[T], f func(T)) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for r := range s {
+			if !yield(r.IfOk(f)) || r.IsErr() {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"iter"
+
+	"github.com/Alsond5/gofp"
+)
+
+const ErrEmpty gofp.ResultError = "stream: empty stream"
+
+type Stream[T any] func(yield func(gofp.Result[T]) bool)
+
+func From[T any](seq iter.Seq[T]) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for v := range seq {
+			if !yield(gofp.Ok(v)) {
+				return
+			}
+		}
+	}
+}
+
+func FromResults[T any](seq iter.Seq[gofp.Result[T]]) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for r := range seq {
+			if !yield(r) || r.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+func FromSeq2[T any](seq iter.Seq2[T, error]) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for v, err := range seq {
+			r := gofp.Of(v, err)
+			if !yield(r) || r.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+func Of[T any](values ...T) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		for _, v := range values {
+			if !yield(gofp.Ok(v)) {
+				return
+			}
+		}
+	}
+}
+
+func Fail[T any](err error) Stream[T] {
+	return func(yield func(gofp.Result[T]) bool) {
+		yield(gofp.Err[T](err))
+	}
+}
+
+func (s Stream[T]) All() iter.Seq[gofp.Result[T]] {
+	return iter.Seq[gofp.Result[T]](s)
+}
+
+func (s Stream[T]) Values() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range s {
+			if !yield(r.Unpack()) {
+				return
+			}
+		}
+	}
+}
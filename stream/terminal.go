@@ -0,0 +1,114 @@
+package stream
+
+import "github.com/Alsond5/gofp"
+
+func Fold[T, U any](s Stream[T], initial U, f func(U, T) U) gofp.Result[U] {
+	acc := initial
+	for r := range s {
+		if r.IsErr() {
+			return gofp.Err[U](r.UnwrapErr())
+		}
+
+		acc = f(acc, r.Unwrap())
+	}
+
+	return gofp.Ok(acc)
+}
+
+func TryFold[T, U any](s Stream[T], initial U, f func(U, T) gofp.Result[U]) gofp.Result[U] {
+	acc := initial
+	for r := range s {
+		if r.IsErr() {
+			return gofp.Err[U](r.UnwrapErr())
+		}
+
+		next := f(acc, r.Unwrap())
+		if next.IsErr() {
+			return next
+		}
+
+		acc = next.Unwrap()
+	}
+
+	return gofp.Ok(acc)
+}
+
+func Reduce[T any](s Stream[T], f func(T, T) T) gofp.Result[T] {
+	var acc T
+	started := false
+	for r := range s {
+		if r.IsErr() {
+			return r
+		}
+
+		if started {
+			acc = f(acc, r.Unwrap())
+		} else {
+			acc, started = r.Unwrap(), true
+		}
+	}
+	if !started {
+		return gofp.Err[T](ErrEmpty)
+	}
+
+	return gofp.Ok(acc)
+}
+
+func ToSlice[T any](s Stream[T]) gofp.Result[[]T] {
+	values := make([]T, 0)
+	for r := range s {
+		if r.IsErr() {
+			return gofp.Err[[]T](r.UnwrapErr())
+		}
+
+		values = append(values, r.Unwrap())
+	}
+
+	return gofp.Ok(values)
+}
+
+func First[T any](s Stream[T]) gofp.Result[T] {
+	for r := range s {
+		return r
+	}
+
+	return gofp.Err[T](ErrEmpty)
+}
+
+func Last[T any](s Stream[T]) gofp.Result[T] {
+	last := gofp.Err[T](ErrEmpty)
+	for r := range s {
+		if r.IsErr() {
+			return r
+		}
+
+		last = r
+	}
+
+	return last
+}
+
+func Count[T any](s Stream[T]) gofp.Result[int] {
+	n := 0
+	for r := range s {
+		if r.IsErr() {
+			return gofp.Err[int](r.UnwrapErr())
+		}
+
+		n++
+	}
+
+	return gofp.Ok(n)
+}
+
+func ForEach[T any](s Stream[T], f func(T)) gofp.Result[gofp.Unit] {
+	for r := range s {
+		if r.IsErr() {
+			return gofp.Err[gofp.Unit](r.UnwrapErr())
+		}
+
+		f(r.Unwrap())
+	}
+
+	return gofp.Ok(gofp.Unit{})
+}
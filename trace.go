@@ -0,0 +1,85 @@
+package gofp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+)
+
+const maxStackDepth = 32
+
+type StackTracer interface {
+	StackTrace() []runtime.Frame
+}
+
+type tracedError struct {
+	err error
+	pcs []uintptr
+}
+
+func ErrTrace[T any](err error) Result[T] {
+	if err == nil {
+		return Err[T](nil)
+	}
+
+	return Result[T]{err: withStack(err, 3), ok: false}
+}
+
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return withStack(err, 3)
+}
+
+func withStack(err error, skip int) error {
+	var st StackTracer
+	if errors.As(err, &st) {
+		return err
+	}
+
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip, pcs)
+
+	return &tracedError{err: err, pcs: pcs[:n]}
+}
+
+func (e *tracedError) Error() string { return e.err.Error() }
+
+func (e *tracedError) Unwrap() error { return e.err }
+
+func (e *tracedError) StackTrace() []runtime.Frame {
+	frames := runtime.CallersFrames(e.pcs)
+	out := make([]runtime.Frame, 0, len(e.pcs))
+	for {
+		frame, more := frames.Next()
+		out = append(out, frame)
+		if !more {
+			break
+		}
+	}
+
+	return out
+}
+
+func (e *tracedError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			fmt.Fprintf(s, "%+v", e.err)
+			for _, frame := range e.StackTrace() {
+				fmt.Fprintf(s, "\n\t%s\n\t\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+
+			return
+		}
+
+		io.WriteString(s, e.err.Error())
+	case 's':
+		io.WriteString(s, e.err.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.err.Error())
+	}
+}
@@ -0,0 +1,25 @@
+package gofp
+
+import (
+	"errors"
+	"testing"
+)
+
+var (
+	errBench  = errors.New("bench")
+	sinkError Result[int]
+)
+
+func BenchmarkErr(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		sinkError = Err[int](errBench)
+	}
+}
+
+func BenchmarkErrTrace(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		sinkError = ErrTrace[int](errBench)
+	}
+}
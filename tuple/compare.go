@@ -0,0 +1,183 @@
+package tuple
+
+import "cmp"
+
+func EqualPair[A, B comparable](x, y Pair[A, B]) bool {
+	return x == y
+}
+
+func EqualTriple[A, B, C comparable](x, y Triple[A, B, C]) bool {
+	return x == y
+}
+
+func EqualTuple4[A, B, C, D comparable](x, y Tuple4[A, B, C, D]) bool {
+	return x == y
+}
+
+func EqualTuple5[A, B, C, D, E comparable](x, y Tuple5[A, B, C, D, E]) bool {
+	return x == y
+}
+
+func EqualTuple6[A, B, C, D, E, F comparable](x, y Tuple6[A, B, C, D, E, F]) bool {
+	return x == y
+}
+
+func EqualTuple7[A, B, C, D, E, F, G comparable](x, y Tuple7[A, B, C, D, E, F, G]) bool {
+	return x == y
+}
+
+func EqualTuple8[A, B, C, D, E, F, G, H comparable](x, y Tuple8[A, B, C, D, E, F, G, H]) bool {
+	return x == y
+}
+
+func EqualTuple9[A, B, C, D, E, F, G, H, I comparable](x, y Tuple9[A, B, C, D, E, F, G, H, I]) bool {
+	return x == y
+}
+
+func ComparePair[A, B cmp.Ordered](x, y Pair[A, B]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Second, y.Second)
+}
+
+func CompareTriple[A, B, C cmp.Ordered](x, y Triple[A, B, C]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Third, y.Third)
+}
+
+func CompareTuple4[A, B, C, D cmp.Ordered](x, y Tuple4[A, B, C, D]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Fourth, y.Fourth)
+}
+
+func CompareTuple5[A, B, C, D, E cmp.Ordered](x, y Tuple5[A, B, C, D, E]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fourth, y.Fourth); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Fifth, y.Fifth)
+}
+
+func CompareTuple6[A, B, C, D, E, F cmp.Ordered](x, y Tuple6[A, B, C, D, E, F]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fourth, y.Fourth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fifth, y.Fifth); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Sixth, y.Sixth)
+}
+
+func CompareTuple7[A, B, C, D, E, F, G cmp.Ordered](x, y Tuple7[A, B, C, D, E, F, G]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fourth, y.Fourth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fifth, y.Fifth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Sixth, y.Sixth); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Seventh, y.Seventh)
+}
+
+func CompareTuple8[A, B, C, D, E, F, G, H cmp.Ordered](x, y Tuple8[A, B, C, D, E, F, G, H]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fourth, y.Fourth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fifth, y.Fifth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Sixth, y.Sixth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Seventh, y.Seventh); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Eighth, y.Eighth)
+}
+
+func CompareTuple9[A, B, C, D, E, F, G, H, I cmp.Ordered](x, y Tuple9[A, B, C, D, E, F, G, H, I]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Third, y.Third); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fourth, y.Fourth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Fifth, y.Fifth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Sixth, y.Sixth); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Seventh, y.Seventh); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Eighth, y.Eighth); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(x.Ninth, y.Ninth)
+}
@@ -0,0 +1,102 @@
+package tuple
+
+import (
+	"fmt"
+	"strings"
+)
+
+func format(values ...any) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, v := range values {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		fmt.Fprintf(&b, "%v", v)
+	}
+	b.WriteByte(')')
+
+	return b.String()
+}
+
+var fieldNames = [...]string{"First", "Second", "Third", "Fourth", "Fifth", "Sixth", "Seventh", "Eighth", "Ninth"}
+
+func goString(name string, values ...any) string {
+	var b strings.Builder
+	b.WriteString("tuple." + name + "{")
+	for i, v := range values {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		fmt.Fprintf(&b, "%s: %#v", fieldNames[i], v)
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+func (t Pair[A, B]) String() string {
+	return format(t.First, t.Second)
+}
+
+func (t Pair[A, B]) GoString() string {
+	return goString("Pair", t.First, t.Second)
+}
+
+func (t Triple[A, B, C]) String() string {
+	return format(t.First, t.Second, t.Third)
+}
+
+func (t Triple[A, B, C]) GoString() string {
+	return goString("Triple", t.First, t.Second, t.Third)
+}
+
+func (t Tuple4[A, B, C, D]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth)
+}
+
+func (t Tuple4[A, B, C, D]) GoString() string {
+	return goString("Tuple4", t.First, t.Second, t.Third, t.Fourth)
+}
+
+func (t Tuple5[A, B, C, D, E]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth)
+}
+
+func (t Tuple5[A, B, C, D, E]) GoString() string {
+	return goString("Tuple5", t.First, t.Second, t.Third, t.Fourth, t.Fifth)
+}
+
+func (t Tuple6[A, B, C, D, E, F]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth)
+}
+
+func (t Tuple6[A, B, C, D, E, F]) GoString() string {
+	return goString("Tuple6", t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth)
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh)
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) GoString() string {
+	return goString("Tuple7", t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh)
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth)
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) GoString() string {
+	return goString("Tuple8", t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth)
+}
+
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth, t.Ninth)
+}
+
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) GoString() string {
+	return goString("Tuple9", t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth, t.Ninth)
+}
@@ -0,0 +1,88 @@
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func decodeArray(data []byte, targets ...any) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(targets) {
+		return fmt.Errorf("tuple: expected a JSON array of %d elements, got %d", len(targets), len(raw))
+	}
+
+	for i, target := range targets {
+		if err := json.Unmarshal(raw[i], target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second})
+}
+
+func (t *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second)
+}
+
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third)
+}
+
+func (t Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth})
+}
+
+func (t *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third, &t.Fourth)
+}
+
+func (t Tuple5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth})
+}
+
+func (t *Tuple5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth)
+}
+
+func (t Tuple6[A, B, C, D, E, F]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth})
+}
+
+func (t *Tuple6[A, B, C, D, E, F]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth)
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh})
+}
+
+func (t *Tuple7[A, B, C, D, E, F, G]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth, &t.Seventh)
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth})
+}
+
+func (t *Tuple8[A, B, C, D, E, F, G, H]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth, &t.Seventh, &t.Eighth)
+}
+
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth, t.Ninth})
+}
+
+func (t *Tuple9[A, B, C, D, E, F, G, H, I]) UnmarshalJSON(data []byte) error {
+	return decodeArray(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth, &t.Seventh, &t.Eighth, &t.Ninth)
+}
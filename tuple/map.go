@@ -0,0 +1,177 @@
+package tuple
+
+func MapPairFirst[A, B, A2 any](t Pair[A, B], f func(A) A2) Pair[A2, B] {
+	return Pair[A2, B]{First: f(t.First), Second: t.Second}
+}
+
+func MapPairSecond[A, B, B2 any](t Pair[A, B], f func(B) B2) Pair[A, B2] {
+	return Pair[A, B2]{First: t.First, Second: f(t.Second)}
+}
+
+func MapTripleFirst[A, B, C, A2 any](t Triple[A, B, C], f func(A) A2) Triple[A2, B, C] {
+	return Triple[A2, B, C]{First: f(t.First), Second: t.Second, Third: t.Third}
+}
+
+func MapTripleSecond[A, B, C, B2 any](t Triple[A, B, C], f func(B) B2) Triple[A, B2, C] {
+	return Triple[A, B2, C]{First: t.First, Second: f(t.Second), Third: t.Third}
+}
+
+func MapTripleThird[A, B, C, C2 any](t Triple[A, B, C], f func(C) C2) Triple[A, B, C2] {
+	return Triple[A, B, C2]{First: t.First, Second: t.Second, Third: f(t.Third)}
+}
+
+func MapTuple4First[A, B, C, D, A2 any](t Tuple4[A, B, C, D], f func(A) A2) Tuple4[A2, B, C, D] {
+	return Tuple4[A2, B, C, D]{First: f(t.First), Second: t.Second, Third: t.Third, Fourth: t.Fourth}
+}
+
+func MapTuple4Second[A, B, C, D, B2 any](t Tuple4[A, B, C, D], f func(B) B2) Tuple4[A, B2, C, D] {
+	return Tuple4[A, B2, C, D]{First: t.First, Second: f(t.Second), Third: t.Third, Fourth: t.Fourth}
+}
+
+func MapTuple4Third[A, B, C, D, C2 any](t Tuple4[A, B, C, D], f func(C) C2) Tuple4[A, B, C2, D] {
+	return Tuple4[A, B, C2, D]{First: t.First, Second: t.Second, Third: f(t.Third), Fourth: t.Fourth}
+}
+
+func MapTuple4Fourth[A, B, C, D, D2 any](t Tuple4[A, B, C, D], f func(D) D2) Tuple4[A, B, C, D2] {
+	return Tuple4[A, B, C, D2]{First: t.First, Second: t.Second, Third: t.Third, Fourth: f(t.Fourth)}
+}
+
+func MapTuple5First[A, B, C, D, E, A2 any](t Tuple5[A, B, C, D, E], f func(A) A2) Tuple5[A2, B, C, D, E] {
+	return Tuple5[A2, B, C, D, E]{First: f(t.First), Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth}
+}
+
+func MapTuple5Second[A, B, C, D, E, B2 any](t Tuple5[A, B, C, D, E], f func(B) B2) Tuple5[A, B2, C, D, E] {
+	return Tuple5[A, B2, C, D, E]{First: t.First, Second: f(t.Second), Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth}
+}
+
+func MapTuple5Third[A, B, C, D, E, C2 any](t Tuple5[A, B, C, D, E], f func(C) C2) Tuple5[A, B, C2, D, E] {
+	return Tuple5[A, B, C2, D, E]{First: t.First, Second: t.Second, Third: f(t.Third), Fourth: t.Fourth, Fifth: t.Fifth}
+}
+
+func MapTuple5Fourth[A, B, C, D, E, D2 any](t Tuple5[A, B, C, D, E], f func(D) D2) Tuple5[A, B, C, D2, E] {
+	return Tuple5[A, B, C, D2, E]{First: t.First, Second: t.Second, Third: t.Third, Fourth: f(t.Fourth), Fifth: t.Fifth}
+}
+
+func MapTuple5Fifth[A, B, C, D, E, E2 any](t Tuple5[A, B, C, D, E], f func(E) E2) Tuple5[A, B, C, D, E2] {
+	return Tuple5[A, B, C, D, E2]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: f(t.Fifth)}
+}
+
+func MapTuple6First[A, B, C, D, E, F, A2 any](t Tuple6[A, B, C, D, E, F], f func(A) A2) Tuple6[A2, B, C, D, E, F] {
+	return Tuple6[A2, B, C, D, E, F]{First: f(t.First), Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth}
+}
+
+func MapTuple6Second[A, B, C, D, E, F, B2 any](t Tuple6[A, B, C, D, E, F], f func(B) B2) Tuple6[A, B2, C, D, E, F] {
+	return Tuple6[A, B2, C, D, E, F]{First: t.First, Second: f(t.Second), Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth}
+}
+
+func MapTuple6Third[A, B, C, D, E, F, C2 any](t Tuple6[A, B, C, D, E, F], f func(C) C2) Tuple6[A, B, C2, D, E, F] {
+	return Tuple6[A, B, C2, D, E, F]{First: t.First, Second: t.Second, Third: f(t.Third), Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth}
+}
+
+func MapTuple6Fourth[A, B, C, D, E, F, D2 any](t Tuple6[A, B, C, D, E, F], f func(D) D2) Tuple6[A, B, C, D2, E, F] {
+	return Tuple6[A, B, C, D2, E, F]{First: t.First, Second: t.Second, Third: t.Third, Fourth: f(t.Fourth), Fifth: t.Fifth, Sixth: t.Sixth}
+}
+
+func MapTuple6Fifth[A, B, C, D, E, F, E2 any](t Tuple6[A, B, C, D, E, F], f func(E) E2) Tuple6[A, B, C, D, E2, F] {
+	return Tuple6[A, B, C, D, E2, F]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: f(t.Fifth), Sixth: t.Sixth}
+}
+
+func MapTuple6Sixth[A, B, C, D, E, F, F2 any](t Tuple6[A, B, C, D, E, F], f func(F) F2) Tuple6[A, B, C, D, E, F2] {
+	return Tuple6[A, B, C, D, E, F2]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: f(t.Sixth)}
+}
+
+func MapTuple7First[A, B, C, D, E, F, G, A2 any](t Tuple7[A, B, C, D, E, F, G], f func(A) A2) Tuple7[A2, B, C, D, E, F, G] {
+	return Tuple7[A2, B, C, D, E, F, G]{First: f(t.First), Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh}
+}
+
+func MapTuple7Second[A, B, C, D, E, F, G, B2 any](t Tuple7[A, B, C, D, E, F, G], f func(B) B2) Tuple7[A, B2, C, D, E, F, G] {
+	return Tuple7[A, B2, C, D, E, F, G]{First: t.First, Second: f(t.Second), Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh}
+}
+
+func MapTuple7Third[A, B, C, D, E, F, G, C2 any](t Tuple7[A, B, C, D, E, F, G], f func(C) C2) Tuple7[A, B, C2, D, E, F, G] {
+	return Tuple7[A, B, C2, D, E, F, G]{First: t.First, Second: t.Second, Third: f(t.Third), Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh}
+}
+
+func MapTuple7Fourth[A, B, C, D, E, F, G, D2 any](t Tuple7[A, B, C, D, E, F, G], f func(D) D2) Tuple7[A, B, C, D2, E, F, G] {
+	return Tuple7[A, B, C, D2, E, F, G]{First: t.First, Second: t.Second, Third: t.Third, Fourth: f(t.Fourth), Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh}
+}
+
+func MapTuple7Fifth[A, B, C, D, E, F, G, E2 any](t Tuple7[A, B, C, D, E, F, G], f func(E) E2) Tuple7[A, B, C, D, E2, F, G] {
+	return Tuple7[A, B, C, D, E2, F, G]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: f(t.Fifth), Sixth: t.Sixth, Seventh: t.Seventh}
+}
+
+func MapTuple7Sixth[A, B, C, D, E, F, G, F2 any](t Tuple7[A, B, C, D, E, F, G], f func(F) F2) Tuple7[A, B, C, D, E, F2, G] {
+	return Tuple7[A, B, C, D, E, F2, G]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: f(t.Sixth), Seventh: t.Seventh}
+}
+
+func MapTuple7Seventh[A, B, C, D, E, F, G, G2 any](t Tuple7[A, B, C, D, E, F, G], f func(G) G2) Tuple7[A, B, C, D, E, F, G2] {
+	return Tuple7[A, B, C, D, E, F, G2]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: f(t.Seventh)}
+}
+
+func MapTuple8First[A, B, C, D, E, F, G, H, A2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(A) A2) Tuple8[A2, B, C, D, E, F, G, H] {
+	return Tuple8[A2, B, C, D, E, F, G, H]{First: f(t.First), Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth}
+}
+
+func MapTuple8Second[A, B, C, D, E, F, G, H, B2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(B) B2) Tuple8[A, B2, C, D, E, F, G, H] {
+	return Tuple8[A, B2, C, D, E, F, G, H]{First: t.First, Second: f(t.Second), Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth}
+}
+
+func MapTuple8Third[A, B, C, D, E, F, G, H, C2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(C) C2) Tuple8[A, B, C2, D, E, F, G, H] {
+	return Tuple8[A, B, C2, D, E, F, G, H]{First: t.First, Second: t.Second, Third: f(t.Third), Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth}
+}
+
+func MapTuple8Fourth[A, B, C, D, E, F, G, H, D2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(D) D2) Tuple8[A, B, C, D2, E, F, G, H] {
+	return Tuple8[A, B, C, D2, E, F, G, H]{First: t.First, Second: t.Second, Third: t.Third, Fourth: f(t.Fourth), Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth}
+}
+
+func MapTuple8Fifth[A, B, C, D, E, F, G, H, E2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(E) E2) Tuple8[A, B, C, D, E2, F, G, H] {
+	return Tuple8[A, B, C, D, E2, F, G, H]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: f(t.Fifth), Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth}
+}
+
+func MapTuple8Sixth[A, B, C, D, E, F, G, H, F2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(F) F2) Tuple8[A, B, C, D, E, F2, G, H] {
+	return Tuple8[A, B, C, D, E, F2, G, H]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: f(t.Sixth), Seventh: t.Seventh, Eighth: t.Eighth}
+}
+
+func MapTuple8Seventh[A, B, C, D, E, F, G, H, G2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(G) G2) Tuple8[A, B, C, D, E, F, G2, H] {
+	return Tuple8[A, B, C, D, E, F, G2, H]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: f(t.Seventh), Eighth: t.Eighth}
+}
+
+func MapTuple8Eighth[A, B, C, D, E, F, G, H, H2 any](t Tuple8[A, B, C, D, E, F, G, H], f func(H) H2) Tuple8[A, B, C, D, E, F, G, H2] {
+	return Tuple8[A, B, C, D, E, F, G, H2]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: f(t.Eighth)}
+}
+
+func MapTuple9First[A, B, C, D, E, F, G, H, I, A2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(A) A2) Tuple9[A2, B, C, D, E, F, G, H, I] {
+	return Tuple9[A2, B, C, D, E, F, G, H, I]{First: f(t.First), Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Second[A, B, C, D, E, F, G, H, I, B2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(B) B2) Tuple9[A, B2, C, D, E, F, G, H, I] {
+	return Tuple9[A, B2, C, D, E, F, G, H, I]{First: t.First, Second: f(t.Second), Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Third[A, B, C, D, E, F, G, H, I, C2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(C) C2) Tuple9[A, B, C2, D, E, F, G, H, I] {
+	return Tuple9[A, B, C2, D, E, F, G, H, I]{First: t.First, Second: t.Second, Third: f(t.Third), Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Fourth[A, B, C, D, E, F, G, H, I, D2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(D) D2) Tuple9[A, B, C, D2, E, F, G, H, I] {
+	return Tuple9[A, B, C, D2, E, F, G, H, I]{First: t.First, Second: t.Second, Third: t.Third, Fourth: f(t.Fourth), Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Fifth[A, B, C, D, E, F, G, H, I, E2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(E) E2) Tuple9[A, B, C, D, E2, F, G, H, I] {
+	return Tuple9[A, B, C, D, E2, F, G, H, I]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: f(t.Fifth), Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Sixth[A, B, C, D, E, F, G, H, I, F2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(F) F2) Tuple9[A, B, C, D, E, F2, G, H, I] {
+	return Tuple9[A, B, C, D, E, F2, G, H, I]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: f(t.Sixth), Seventh: t.Seventh, Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Seventh[A, B, C, D, E, F, G, H, I, G2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(G) G2) Tuple9[A, B, C, D, E, F, G2, H, I] {
+	return Tuple9[A, B, C, D, E, F, G2, H, I]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: f(t.Seventh), Eighth: t.Eighth, Ninth: t.Ninth}
+}
+
+func MapTuple9Eighth[A, B, C, D, E, F, G, H, I, H2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(H) H2) Tuple9[A, B, C, D, E, F, G, H2, I] {
+	return Tuple9[A, B, C, D, E, F, G, H2, I]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: f(t.Eighth), Ninth: t.Ninth}
+}
+
+func MapTuple9Ninth[A, B, C, D, E, F, G, H, I, I2 any](t Tuple9[A, B, C, D, E, F, G, H, I], f func(I) I2) Tuple9[A, B, C, D, E, F, G, H, I2] {
+	return Tuple9[A, B, C, D, E, F, G, H, I2]{First: t.First, Second: t.Second, Third: t.Third, Fourth: t.Fourth, Fifth: t.Fifth, Sixth: t.Sixth, Seventh: t.Seventh, Eighth: t.Eighth, Ninth: f(t.Ninth)}
+}
@@ -10,3 +10,124 @@ type Triple[A, B, C any] struct {
 	Second B
 	Third  C
 }
+
+type Tuple4[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+type Tuple5[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+type Tuple6[A, B, C, D, E, F any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+	Sixth  F
+}
+
+type Tuple7[A, B, C, D, E, F, G any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+}
+
+type Tuple8[A, B, C, D, E, F, G, H any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+	Eighth  H
+}
+
+type Tuple9[A, B, C, D, E, F, G, H, I any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+	Eighth  H
+	Ninth   I
+}
+
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+func NewTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{First: a, Second: b, Third: c, Fourth: d}
+}
+
+func NewTuple5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{First: a, Second: b, Third: c, Fourth: d, Fifth: e}
+}
+
+func NewTuple6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f}
+}
+
+func NewTuple7[A, B, C, D, E, F, G any](a A, b B, c C, d D, e E, f F, g G) Tuple7[A, B, C, D, E, F, G] {
+	return Tuple7[A, B, C, D, E, F, G]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g}
+}
+
+func NewTuple8[A, B, C, D, E, F, G, H any](a A, b B, c C, d D, e E, f F, g G, h H) Tuple8[A, B, C, D, E, F, G, H] {
+	return Tuple8[A, B, C, D, E, F, G, H]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g, Eighth: h}
+}
+
+func NewTuple9[A, B, C, D, E, F, G, H, I any](a A, b B, c C, d D, e E, f F, g G, h H, i I) Tuple9[A, B, C, D, E, F, G, H, I] {
+	return Tuple9[A, B, C, D, E, F, G, H, I]{First: a, Second: b, Third: c, Fourth: d, Fifth: e, Sixth: f, Seventh: g, Eighth: h, Ninth: i}
+}
+
+func (t Pair[A, B]) Unpack() (A, B) {
+	return t.First, t.Second
+}
+
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.First, t.Second, t.Third, t.Fourth
+}
+
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth
+}
+
+func (t Tuple6[A, B, C, D, E, F]) Unpack() (A, B, C, D, E, F) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth
+}
+
+func (t Tuple7[A, B, C, D, E, F, G]) Unpack() (A, B, C, D, E, F, G) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh
+}
+
+func (t Tuple8[A, B, C, D, E, F, G, H]) Unpack() (A, B, C, D, E, F, G, H) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth
+}
+
+func (t Tuple9[A, B, C, D, E, F, G, H, I]) Unpack() (A, B, C, D, E, F, G, H, I) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth, t.Ninth
+}
@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+type Errors []*FieldError
+
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+
+	return errs
+}
+
+func (es Errors) Map() map[string][]string {
+	m := make(map[string][]string, len(es))
+	for _, e := range es {
+		m[e.Path] = append(m[e.Path], e.Err.Error())
+	}
+
+	return m
+}
+
+func (es Errors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(es.Map())
+}
+
+func (es Errors) Nest(prefix string) Errors {
+	if prefix == "" {
+		return es
+	}
+
+	out := make(Errors, len(es))
+	for i, e := range es {
+		out[i] = &FieldError{Path: JoinPath(prefix, e.Path), Err: e.Err}
+	}
+
+	return out
+}
+
+func JoinPath(prefix, path string) string {
+	switch {
+	case prefix == "":
+		return path
+	case path == "":
+		return prefix
+	case strings.HasPrefix(path, "["):
+		return prefix + path
+	default:
+		return prefix + "." + path
+	}
+}
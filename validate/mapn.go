@@ -0,0 +1,57 @@
+package validate
+
+func Map2[A, B, R any](a Validated[A], b Validated[B], fn func(A, B) R) Validated[R] {
+	if errs := collect(a.errs, b.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value))
+}
+
+func Map3[A, B, C, R any](a Validated[A], b Validated[B], c Validated[C], fn func(A, B, C) R) Validated[R] {
+	if errs := collect(a.errs, b.errs, c.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value, c.value))
+}
+
+func Map4[A, B, C, D, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], fn func(A, B, C, D) R) Validated[R] {
+	if errs := collect(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value, c.value, d.value))
+}
+
+func Map5[A, B, C, D, E, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], fn func(A, B, C, D, E) R) Validated[R] {
+	if errs := collect(a.errs, b.errs, c.errs, d.errs, e.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value))
+}
+
+func Map6[A, B, C, D, E, F, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], f Validated[F], fn func(A, B, C, D, E, F) R) Validated[R] {
+	if errs := collect(a.errs, b.errs, c.errs, d.errs, e.errs, f.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value))
+}
+
+func Map7[A, B, C, D, E, F, G, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], f Validated[F], g Validated[G], fn func(A, B, C, D, E, F, G) R) Validated[R] {
+	if errs := collect(a.errs, b.errs, c.errs, d.errs, e.errs, f.errs, g.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value))
+}
+
+func Map8[A, B, C, D, E, F, G, H, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], f Validated[F], g Validated[G], h Validated[H], fn func(A, B, C, D, E, F, G, H) R) Validated[R] {
+	if errs := collect(a.errs, b.errs, c.errs, d.errs, e.errs, f.errs, g.errs, h.errs); len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+
+	return Valid(fn(a.value, b.value, c.value, d.value, e.value, f.value, g.value, h.value))
+}
@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/Alsond5/gofp"
+)
+
+const (
+	ErrRequired   gofp.ResultError = "is required"
+	ErrTooShort   gofp.ResultError = "is too short"
+	ErrTooLong    gofp.ResultError = "is too long"
+	ErrOutOfRange gofp.ResultError = "is out of range"
+	ErrPattern    gofp.ResultError = "does not match the expected format"
+	ErrNotAllowed gofp.ResultError = "is not an allowed value"
+)
+
+type Rule[T any] func(T) error
+
+func Validate[T any](value T, rules ...Rule[T]) gofp.Result[T] {
+	for _, rule := range rules {
+		if err := rule(value); err != nil {
+			return gofp.Err[T](err)
+		}
+	}
+
+	return gofp.Ok(value)
+}
+
+func Check[T any](path string, value T, rules ...Rule[T]) Validated[T] {
+	return Field(path, Validate(value, rules...))
+}
+
+func Compose[T any](rules ...Rule[T]) Rule[T] {
+	return func(value T) error {
+		return Validate(value, rules...).IntoErr()
+	}
+}
+
+func Satisfy[T any](pred func(T) bool, msg string) Rule[T] {
+	return func(value T) error {
+		if !pred(value) {
+			return gofp.ResultError(msg)
+		}
+
+		return nil
+	}
+}
+
+func Required[T comparable]() Rule[T] {
+	return func(value T) error {
+		var zero T
+		if value == zero {
+			return ErrRequired
+		}
+
+		return nil
+	}
+}
+
+func MinLen(n int) Rule[string] {
+	return func(value string) error {
+		if utf8.RuneCountInString(value) < n {
+			return fmt.Errorf("%w: at least %d characters", ErrTooShort, n)
+		}
+
+		return nil
+	}
+}
+
+func MaxLen(n int) Rule[string] {
+	return func(value string) error {
+		if utf8.RuneCountInString(value) > n {
+			return fmt.Errorf("%w: at most %d characters", ErrTooLong, n)
+		}
+
+		return nil
+	}
+}
+
+func MinItems[E any](n int) Rule[[]E] {
+	return func(value []E) error {
+		if len(value) < n {
+			return fmt.Errorf("%w: at least %d items", ErrTooShort, n)
+		}
+
+		return nil
+	}
+}
+
+func MaxItems[E any](n int) Rule[[]E] {
+	return func(value []E) error {
+		if len(value) > n {
+			return fmt.Errorf("%w: at most %d items", ErrTooLong, n)
+		}
+
+		return nil
+	}
+}
+
+func Range[T cmp.Ordered](min, max T) Rule[T] {
+	return func(value T) error {
+		if value < min || value > max {
+			return fmt.Errorf("%w: expected %v to %v", ErrOutOfRange, min, max)
+		}
+
+		return nil
+	}
+}
+
+func Regex(re *regexp.Regexp) Rule[string] {
+	return func(value string) error {
+		if !re.MatchString(value) {
+			return ErrPattern
+		}
+
+		return nil
+	}
+}
+
+func OneOf[T comparable](allowed ...T) Rule[T] {
+	return func(value T) error {
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("%w: expected one of %v", ErrNotAllowed, allowed)
+		}
+
+		return nil
+	}
+}
+
+func Each[T any](rules ...Rule[T]) Rule[[]T] {
+	return func(values []T) error {
+		var errs Errors
+		for i, v := range values {
+			if err := Validate(v, rules...).IntoErr(); err != nil {
+				errs = append(errs, toErrors(Index("", i), err)...)
+			}
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+
+		return nil
+	}
+}
+
+func Optional[T any](rules ...Rule[T]) Rule[gofp.Option[T]] {
+	return func(value gofp.Option[T]) error {
+		if value.IsNone() {
+			return nil
+		}
+
+		return Validate(value.Unwrap(), rules...).IntoErr()
+	}
+}
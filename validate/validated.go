@@ -0,0 +1,118 @@
+package validate
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Alsond5/gofp"
+)
+
+type Validated[T any] struct {
+	value T
+	errs  Errors
+}
+
+func Valid[T any](value T) Validated[T] {
+	return Validated[T]{value: value}
+}
+
+func Invalid[T any](path string, err error) Validated[T] {
+	return Validated[T]{errs: toErrors(path, err)}
+}
+
+func Field[T any](path string, r gofp.Result[T]) Validated[T] {
+	if r.IsErr() {
+		return Invalid[T](path, r.UnwrapErr())
+	}
+
+	return Valid(r.Unwrap())
+}
+
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func toErrors(path string, err error) Errors {
+	if err == nil {
+		err = gofp.ErrZeroResult
+	}
+
+	var es Errors
+	if errors.As(err, &es) {
+		return es.Nest(path)
+	}
+
+	return Errors{{Path: path, Err: err}}
+}
+
+func (v Validated[T]) IsValid() bool { return len(v.errs) == 0 }
+
+func (v Validated[T]) IsInvalid() bool { return len(v.errs) > 0 }
+
+func (v Validated[T]) Errors() Errors {
+	return v.errs
+}
+
+func (v Validated[T]) Nest(prefix string) Validated[T] {
+	if v.IsValid() {
+		return v
+	}
+
+	return Validated[T]{errs: v.errs.Nest(prefix)}
+}
+
+func (v Validated[T]) Result() gofp.Result[T] {
+	if v.IsInvalid() {
+		return gofp.Err[T](v.errs)
+	}
+
+	return gofp.Ok(v.value)
+}
+
+func (v Validated[T]) UnwrapOr(defaultValue T) T {
+	if v.IsInvalid() {
+		return defaultValue
+	}
+
+	return v.value
+}
+
+func Map[T, U any](v Validated[T], f func(T) U) Validated[U] {
+	if v.IsInvalid() {
+		return Validated[U]{errs: v.errs}
+	}
+
+	return Valid(f(v.value))
+}
+
+func FlatMap[T, U any](v Validated[T], f func(T) Validated[U]) Validated[U] {
+	if v.IsInvalid() {
+		return Validated[U]{errs: v.errs}
+	}
+
+	return f(v.value)
+}
+
+func All[T any](vs ...Validated[T]) Validated[[]T] {
+	values := make([]T, 0, len(vs))
+
+	var errs Errors
+	for _, v := range vs {
+		errs = append(errs, v.errs...)
+		values = append(values, v.value)
+	}
+	if len(errs) > 0 {
+		return Validated[[]T]{errs: errs}
+	}
+
+	return Valid(values)
+}
+
+func collect(errs ...Errors) Errors {
+	var out Errors
+	for _, es := range errs {
+		out = append(out, es...)
+	}
+
+	return out
+}